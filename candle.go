@@ -0,0 +1,80 @@
+package tango
+
+import (
+	"time"
+
+	"github.com/shopspring/decimal"
+)
+
+// Candle represents a single candlestick (OHLCV bar) in a financial chart.
+type Candle struct {
+	// Open  is the opening price of the candle.
+	Open decimal.Decimal
+
+	// High  is the highest price of the candle.
+	High decimal.Decimal
+
+	// Low   is the lowest price of the candle.
+	Low decimal.Decimal
+
+	// Close is the closing price of the candle.
+	Close decimal.Decimal
+
+	// Volume is the traded volume of the candle.
+	Volume decimal.Decimal
+
+	// Time is the opening time of the candle.
+	Time time.Time
+
+	// Interval is the duration of the candle.
+	Interval time.Duration
+}
+
+// CloseTime returns the closing time of the candle.
+func (c Candle) CloseTime() time.Time {
+	return c.Time.Add(c.Interval)
+}
+
+// TypicalPrice calculates the typical price of the candle: (H+L+C)/3.
+func (c Candle) TypicalPrice() decimal.Decimal {
+	return c.High.Add(c.Low).Add(c.Close).Div(decimal.NewFromInt(3))
+}
+
+// typicalPrices calculates the typical price of every candle.
+func typicalPrices(cc []Candle) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		res[i] = cc[i].TypicalPrice()
+	}
+
+	return res
+}
+
+// MedianPrice calculates the median price of the candle: (H+L)/2.
+func (c Candle) MedianPrice() decimal.Decimal {
+	return c.High.Add(c.Low).Div(decimal.NewFromInt(2))
+}
+
+// WeightedClose calculates the weighted close price of the candle:
+// (H+L+2C)/4.
+func (c Candle) WeightedClose() decimal.Decimal {
+	return c.High.Add(c.Low).Add(c.Close.Mul(decimal.NewFromInt(2))).Div(decimal.NewFromInt(4))
+}
+
+// Range calculates the difference between the highest and the lowest
+// prices of the candle.
+func (c Candle) Range() decimal.Decimal {
+	return c.High.Sub(c.Low)
+}
+
+// TrueRange calculates the true range of the candle, which, in addition
+// to the candle range, includes the gap from the previous candle's close.
+// https://www.investopedia.com/terms/a/atr.asp.
+func (c Candle) TrueRange(prev Candle) decimal.Decimal {
+	return decimal.Max(
+		c.Range(),
+		c.High.Sub(prev.Close).Abs(),
+		c.Low.Sub(prev.Close).Abs(),
+	)
+}
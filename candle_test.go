@@ -0,0 +1,106 @@
+package tango
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_Candle_CloseTime(t *testing.T) {
+	c := Candle{
+		Time:     time.Date(2023, 1, 1, 10, 0, 0, 0, time.UTC),
+		Interval: time.Minute,
+	}
+
+	assert.Equal(t, time.Date(2023, 1, 1, 10, 1, 0, 0, time.UTC), c.CloseTime())
+}
+
+func Test_Candle_TypicalPrice(t *testing.T) {
+	c := Candle{
+		High:  decimal.NewFromInt(30),
+		Low:   decimal.NewFromInt(10),
+		Close: decimal.NewFromInt(26),
+	}
+
+	assert.Equal(t, "22", c.TypicalPrice().String())
+}
+
+func Test_Candle_MedianPrice(t *testing.T) {
+	c := Candle{
+		High:  decimal.NewFromInt(30),
+		Low:   decimal.NewFromInt(15),
+		Close: decimal.NewFromInt(26),
+	}
+
+	assert.Equal(t, "22.5", c.MedianPrice().String())
+}
+
+func Test_Candle_WeightedClose(t *testing.T) {
+	c := Candle{
+		High:  decimal.NewFromInt(30),
+		Low:   decimal.NewFromInt(10),
+		Close: decimal.NewFromInt(26),
+	}
+
+	assert.Equal(t, "23", c.WeightedClose().String())
+}
+
+func Test_Candle_Range(t *testing.T) {
+	c := Candle{
+		High: decimal.NewFromInt(30),
+		Low:  decimal.NewFromInt(10),
+	}
+
+	assert.Equal(t, "20", c.Range().String())
+}
+
+func Test_Candle_TrueRange(t *testing.T) {
+	cc := map[string]struct {
+		Candle   Candle
+		Previous Candle
+		Result   decimal.Decimal
+	}{
+		"Candle range is the largest": {
+			Candle: Candle{
+				High: decimal.NewFromInt(30),
+				Low:  decimal.NewFromInt(10),
+			},
+			Previous: Candle{
+				Close: decimal.NewFromInt(20),
+			},
+			Result: decimal.NewFromInt(20),
+		},
+		"Gap up from the previous close": {
+			Candle: Candle{
+				High: decimal.NewFromInt(30),
+				Low:  decimal.NewFromInt(25),
+			},
+			Previous: Candle{
+				Close: decimal.NewFromInt(15),
+			},
+			Result: decimal.NewFromInt(15),
+		},
+		"Gap down from the previous close": {
+			Candle: Candle{
+				High: decimal.NewFromInt(20),
+				Low:  decimal.NewFromInt(15),
+			},
+			Previous: Candle{
+				Close: decimal.NewFromInt(32),
+			},
+			Result: decimal.NewFromInt(17),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.Result.String(), c.Candle.TrueRange(c.Previous).String())
+		})
+	}
+}
@@ -2,6 +2,7 @@ package tango
 
 import (
 	"errors"
+	"strings"
 
 	"github.com/shopspring/decimal"
 )
@@ -18,8 +19,46 @@ const (
 	CandlestickPatternLongLeggedDoji CandlestickPattern = "long-legged-doji"
 	CandlestickPatternDragonflyDoji  CandlestickPattern = "dragonfly-doji"
 	CandlestickPatternGravestoneDoji CandlestickPattern = "gravestone-doji"
+
+	CandlestickPatternBullishEngulfing   CandlestickPattern = "bullish-engulfing"
+	CandlestickPatternBearishEngulfing   CandlestickPattern = "bearish-engulfing"
+	CandlestickPatternBullishHarami      CandlestickPattern = "bullish-harami"
+	CandlestickPatternBearishHarami      CandlestickPattern = "bearish-harami"
+	CandlestickPatternPiercingLine       CandlestickPattern = "piercing-line"
+	CandlestickPatternDarkCloudCover     CandlestickPattern = "dark-cloud-cover"
+	CandlestickPatternTweezerTop         CandlestickPattern = "tweezer-top"
+	CandlestickPatternTweezerBottom      CandlestickPattern = "tweezer-bottom"
+	CandlestickPatternMorningStar        CandlestickPattern = "morning-star"
+	CandlestickPatternEveningStar        CandlestickPattern = "evening-star"
+	CandlestickPatternThreeWhiteSoldiers CandlestickPattern = "three-white-soldiers"
+	CandlestickPatternThreeBlackCrows    CandlestickPattern = "three-black-crows"
 )
 
+// CandlestickPatterns returns all supported candlestick patterns.
+func CandlestickPatterns() []CandlestickPattern {
+	return []CandlestickPattern{
+		CandlestickPatternHammer,
+		CandlestickPatternHangingMan,
+		CandlestickPatternInvertedHammer,
+		CandlestickPatternShootingStar,
+		CandlestickPatternLongLeggedDoji,
+		CandlestickPatternDragonflyDoji,
+		CandlestickPatternGravestoneDoji,
+		CandlestickPatternBullishEngulfing,
+		CandlestickPatternBearishEngulfing,
+		CandlestickPatternBullishHarami,
+		CandlestickPatternBearishHarami,
+		CandlestickPatternPiercingLine,
+		CandlestickPatternDarkCloudCover,
+		CandlestickPatternTweezerTop,
+		CandlestickPatternTweezerBottom,
+		CandlestickPatternMorningStar,
+		CandlestickPatternEveningStar,
+		CandlestickPatternThreeWhiteSoldiers,
+		CandlestickPatternThreeBlackCrows,
+	}
+}
+
 // ErrInvalidCandlestickPattern indicates that the provided candlestick pattern is not valid.
 var ErrInvalidCandlestickPattern = errors.New("invalid candlestick pattern")
 
@@ -32,7 +71,19 @@ func (cp CandlestickPattern) Validate() error {
 		CandlestickPatternShootingStar,
 		CandlestickPatternLongLeggedDoji,
 		CandlestickPatternDragonflyDoji,
-		CandlestickPatternGravestoneDoji:
+		CandlestickPatternGravestoneDoji,
+		CandlestickPatternBullishEngulfing,
+		CandlestickPatternBearishEngulfing,
+		CandlestickPatternBullishHarami,
+		CandlestickPatternBearishHarami,
+		CandlestickPatternPiercingLine,
+		CandlestickPatternDarkCloudCover,
+		CandlestickPatternTweezerTop,
+		CandlestickPatternTweezerBottom,
+		CandlestickPatternMorningStar,
+		CandlestickPatternEveningStar,
+		CandlestickPatternThreeWhiteSoldiers,
+		CandlestickPatternThreeBlackCrows:
 
 		return nil
 	default:
@@ -41,33 +92,458 @@ func (cp CandlestickPattern) Validate() error {
 }
 
 // Eval evaluates whether the given data matches the candlestick pattern.
+// Candles must be ordered from the oldest to the newest. Default
+// recognition thresholds are used, see DefaultCandlestickOptions.
 func (cp CandlestickPattern) Eval(cc []Candle) bool {
+	ok, err := CandlestickRecognizer{
+		valid: true,
+		opts:  DefaultCandlestickOptions(),
+	}.Eval(cp, cc)
+
+	return err == nil && ok
+}
+
+// EvalTrend evaluates whether the given data matches the candlestick
+// pattern preceded by the trend it requires. Default recognition
+// thresholds are used, see CandlestickRecognizer.EvalTrend for details.
+func (cp CandlestickPattern) EvalTrend(td TrendDetector, cc []Candle) bool {
+	ok, err := CandlestickRecognizer{
+		valid: true,
+		opts:  DefaultCandlestickOptions(),
+	}.EvalTrend(cp, td, cc)
+
+	return err == nil && ok
+}
+
+// ScanCandlestickPatterns evaluates all supported candlestick patterns
+// over every position of the provided candles using default recognition
+// thresholds and returns all occurrences ordered by index.
+func ScanCandlestickPatterns(cc []Candle) []CandlestickMatch {
+	res, _ := CandlestickRecognizer{
+		valid: true,
+		opts:  DefaultCandlestickOptions(),
+	}.Scan(cc)
+
+	return res
+}
+
+// Count returns the number of candles the candlestick pattern consists of.
+func (cp CandlestickPattern) Count() int {
+	switch cp {
+	case CandlestickPatternHammer,
+		CandlestickPatternHangingMan,
+		CandlestickPatternInvertedHammer,
+		CandlestickPatternShootingStar,
+		CandlestickPatternLongLeggedDoji,
+		CandlestickPatternDragonflyDoji,
+		CandlestickPatternGravestoneDoji:
+
+		return 1
+	case CandlestickPatternBullishEngulfing,
+		CandlestickPatternBearishEngulfing,
+		CandlestickPatternBullishHarami,
+		CandlestickPatternBearishHarami,
+		CandlestickPatternPiercingLine,
+		CandlestickPatternDarkCloudCover,
+		CandlestickPatternTweezerTop,
+		CandlestickPatternTweezerBottom:
+
+		return 2
+	case CandlestickPatternMorningStar,
+		CandlestickPatternEveningStar,
+		CandlestickPatternThreeWhiteSoldiers,
+		CandlestickPatternThreeBlackCrows:
+
+		return 3
+	default:
+		return 0
+	}
+}
+
+// ErrInvalidCandlestickOptions indicates that the provided candlestick
+// recognition options are not valid.
+var ErrInvalidCandlestickOptions = errors.New("invalid candlestick options")
+
+// CandlestickOptions holds the thresholds used to recognize candlestick
+// patterns. All of the values are ratios relative to the total candle
+// size (the difference between the high and the low of the candle).
+// DefaultCandlestickOptions should be used as a base when only some of
+// the values need to be changed, e.g. before unmarshalling JSON into it.
+type CandlestickOptions struct {
+	// SmallBodyMin specifies the minimum body size of hammer-like
+	// patterns.
+	SmallBodyMin decimal.Decimal `json:"small_body_min"`
+
+	// SmallBodyMax specifies the maximum body size of hammer-like
+	// patterns.
+	SmallBodyMax decimal.Decimal `json:"small_body_max"`
+
+	// DojiBodyMax specifies the maximum body size of doji patterns.
+	DojiBodyMax decimal.Decimal `json:"doji_body_max"`
+
+	// LongBodyMin specifies the minimum body size of a long candle, used
+	// by star, soldiers and crows patterns.
+	LongBodyMin decimal.Decimal `json:"long_body_min"`
+
+	// StarBodyMax specifies the maximum body size of the middle candle
+	// of star patterns.
+	StarBodyMax decimal.Decimal `json:"star_body_max"`
+
+	// ShadowLeeway specifies how far the open or the close of hammer-like
+	// patterns may be from the candle's high or low, i.e. the maximum
+	// size of the short shadow.
+	ShadowLeeway decimal.Decimal `json:"shadow_leeway"`
+
+	// DojiLeeway specifies how far the close of doji patterns may be from
+	// the expected price.
+	DojiLeeway decimal.Decimal `json:"doji_leeway"`
+
+	// TweezerLeeway specifies how far the highs or the lows of tweezer
+	// patterns may be from each other.
+	TweezerLeeway decimal.Decimal `json:"tweezer_leeway"`
+}
+
+// DefaultCandlestickOptions returns the default candlestick recognition
+// thresholds.
+func DefaultCandlestickOptions() CandlestickOptions {
+	return CandlestickOptions{
+		SmallBodyMin:  decimal.NewFromFloat(0.05),
+		SmallBodyMax:  decimal.NewFromFloat(0.2),
+		DojiBodyMax:   decimal.NewFromFloat(0.05),
+		LongBodyMin:   decimal.NewFromFloat(0.5),
+		StarBodyMax:   decimal.NewFromFloat(0.3),
+		ShadowLeeway:  decimal.NewFromFloat(0.10),
+		DojiLeeway:    decimal.NewFromFloat(0.05),
+		TweezerLeeway: decimal.NewFromFloat(0.05),
+	}
+}
+
+// Validate checks whether all of the thresholds are ratios between 0 and 1
+// and the minimum small body size does not exceed the maximum one.
+func (opts CandlestickOptions) Validate() error {
+	for _, v := range []decimal.Decimal{
+		opts.SmallBodyMin,
+		opts.SmallBodyMax,
+		opts.DojiBodyMax,
+		opts.LongBodyMin,
+		opts.StarBodyMax,
+		opts.ShadowLeeway,
+		opts.DojiLeeway,
+		opts.TweezerLeeway,
+	} {
+		if v.LessThan(decimal.Zero) || v.GreaterThan(_one) {
+			return ErrInvalidCandlestickOptions
+		}
+	}
+
+	if opts.SmallBodyMin.GreaterThan(opts.SmallBodyMax) {
+		return ErrInvalidCandlestickOptions
+	}
+
+	return nil
+}
+
+// CandlestickRecognizer holds all the necessary information needed to
+// recognize candlestick patterns.
+// The zero value is not usable.
+type CandlestickRecognizer struct {
+	// valid specifies whether CandlestickRecognizer paremeters were validated.
+	valid bool
+
+	// opts specifies the recognition thresholds.
+	opts CandlestickOptions
+}
+
+// NewCandlestickRecognizer validates provided configuration options and
+// creates new CandlestickRecognizer.
+func NewCandlestickRecognizer(opts CandlestickOptions) (CandlestickRecognizer, error) {
+	if err := opts.Validate(); err != nil {
+		return CandlestickRecognizer{}, err
+	}
+
+	return CandlestickRecognizer{
+		valid: true,
+		opts:  opts,
+	}, nil
+}
+
+// Options returns the recognition thresholds used by the recognizer.
+func (cr CandlestickRecognizer) Options() CandlestickOptions {
+	return cr.opts
+}
+
+// Eval evaluates whether the given candles match the candlestick pattern.
+// Candles must be ordered from the oldest to the newest and their count
+// must match the pattern's Count.
+// Without the preceding trend, Hammer and Inverted Hammer require a
+// positive candle, while Hanging Man and Shooting Star require a negative
+// one.
+func (cr CandlestickRecognizer) Eval(cp CandlestickPattern, cc []Candle) (bool, error) {
+	if !cr.valid {
+		return false, ErrInvalidIndicator
+	}
+
+	if err := cp.Validate(); err != nil {
+		return false, err
+	}
+
 	if len(cc) != cp.Count() {
-		return false
+		return false, ErrInvalidDataSize
+	}
+
+	return cr.eval(cp, cc, false), nil
+}
+
+// eval evaluates whether the given candles match the candlestick pattern.
+// When byShape is true, patterns that share the same shape are matched
+// regardless of the candle color, since the preceding trend tells them
+// apart.
+func (cr CandlestickRecognizer) eval(cp CandlestickPattern, cc []Candle, byShape bool) bool {
+	switch len(cc) {
+	case 1:
+		return cr.evalSingle(cp, cc[0], byShape)
+	case 2:
+		return cr.evalDouble(cp, cc[0], cc[1])
+	default: // pattern is validated, only three candle patterns are left.
+		return cr.evalTriple(cp, cc[0], cc[1], cc[2])
+	}
+}
+
+// EvalTrend evaluates whether the given candles match the candlestick
+// pattern and, for reversal patterns, whether the trend preceding the
+// pattern qualifies: bullish reversal patterns require a downtrend and
+// bearish reversal patterns require an uptrend. The trend alone picks
+// between the patterns sharing the same shape, e.g. a hammer shaped candle
+// is a Hammer after a downtrend and a Hanging Man after an uptrend,
+// regardless of its color. Candles must be ordered from the oldest to the
+// newest; the first td.Count() candles are used to determine the trend and
+// the rest must match the pattern's Count.
+func (cr CandlestickRecognizer) EvalTrend(cp CandlestickPattern, td TrendDetector, cc []Candle) (bool, error) {
+	if !cr.valid || !td.valid {
+		return false, ErrInvalidIndicator
+	}
+
+	if err := cp.Validate(); err != nil {
+		return false, err
+	}
+
+	if len(cc) != td.Count()+cp.Count() {
+		return false, ErrInvalidDataSize
+	}
+
+	trend := cp.precedingTrend()
+
+	if !cr.eval(cp, cc[td.Count():], trend != 0) {
+		return false, nil
+	}
+
+	if trend == 0 {
+		return true, nil
+	}
+
+	res, err := td.Calc(cc[:td.Count()])
+	if err != nil {
+		// unlikely to happen
+		return false, err
+	}
+
+	return res == trend, nil
+}
+
+// CandlestickMatch holds a single occurrence of a candlestick pattern
+// found by the scan.
+type CandlestickMatch struct {
+	// Index is the index of the last candle of the pattern.
+	Index int `json:"index"`
+
+	// Pattern is the matched candlestick pattern.
+	Pattern CandlestickPattern `json:"pattern"`
+
+	// Sentiment is the market sentiment indicated by the pattern.
+	Sentiment Sentiment `json:"sentiment"`
+}
+
+// Scan evaluates all supported candlestick patterns over every position
+// of the provided candles and returns all occurrences ordered by index.
+// Candles must be ordered from the oldest to the newest.
+func (cr CandlestickRecognizer) Scan(cc []Candle) ([]CandlestickMatch, error) {
+	if !cr.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return cr.scan(cc, 0, func(cp CandlestickPattern, cc []Candle) (bool, error) {
+		return cr.Eval(cp, cc)
+	})
+}
+
+// ScanTrend evaluates all supported candlestick patterns, preceded by the
+// trend they require, over every position of the provided candles and
+// returns all occurrences ordered by index. Candles must be ordered from
+// the oldest to the newest.
+func (cr CandlestickRecognizer) ScanTrend(td TrendDetector, cc []Candle) ([]CandlestickMatch, error) {
+	if !cr.valid || !td.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return cr.scan(cc, td.Count(), func(cp CandlestickPattern, cc []Candle) (bool, error) {
+		return cr.EvalTrend(cp, td, cc)
+	})
+}
+
+// scan evaluates all supported candlestick patterns over every position
+// of the provided candles. Each evaluated window additionally contains
+// the given amount of candles preceding the pattern.
+func (cr CandlestickRecognizer) scan(
+	cc []Candle,
+	preceding int,
+	eval func(CandlestickPattern, []Candle) (bool, error),
+) ([]CandlestickMatch, error) {
+
+	var res []CandlestickMatch
+
+	pp := CandlestickPatterns()
+
+	for i := range cc {
+		for _, cp := range pp {
+			start := i - preceding - cp.Count() + 1
+			if start < 0 {
+				continue
+			}
+
+			ok, err := eval(cp, cc[start:i+1])
+			if err != nil {
+				// unlikely to happen
+				return nil, err
+			}
+
+			if ok {
+				res = append(res, CandlestickMatch{
+					Index:     i,
+					Pattern:   cp,
+					Sentiment: cp.Sentiment(),
+				})
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// evalSingle evaluates single candle patterns. When byShape is true, the
+// candle color is not checked.
+func (cr CandlestickRecognizer) evalSingle(cp CandlestickPattern, c Candle, byShape bool) bool {
+	if byShape {
+		switch cp {
+		case CandlestickPatternHammer, CandlestickPatternHangingMan:
+			return cr.evalHammerShape(c)
+		case CandlestickPatternInvertedHammer, CandlestickPatternShootingStar:
+			return cr.evalInvertedHammerShape(c)
+		}
 	}
 
 	switch cp {
 	case CandlestickPatternHammer:
-		return evalHammer(cc[0])
+		return cr.evalHammer(c)
 	case CandlestickPatternHangingMan:
-		return evalHangingMan(cc[0])
+		return cr.evalHangingMan(c)
 	case CandlestickPatternInvertedHammer:
-		return evalInvertedHammer(cc[0])
+		return cr.evalInvertedHammer(c)
 	case CandlestickPatternShootingStar:
-		return evalShootingStar(cc[0])
+		return cr.evalShootingStar(c)
 	case CandlestickPatternLongLeggedDoji:
-		return evalLongLeggedDoji(cc[0])
+		return cr.evalLongLeggedDoji(c)
 	case CandlestickPatternDragonflyDoji:
-		return evalDragonflyDoji(cc[0])
+		return cr.evalDragonflyDoji(c)
 	case CandlestickPatternGravestoneDoji:
-		return evalGravestoneDoji(cc[0])
+		return cr.evalGravestoneDoji(c)
 	default:
 		return false
 	}
 }
 
-// Count returns the number of occurrences of the candlestick pattern.
-func (cp CandlestickPattern) Count() int {
+// evalDouble evaluates two candle patterns.
+func (cr CandlestickRecognizer) evalDouble(cp CandlestickPattern, c1, c2 Candle) bool {
+	switch cp {
+	case CandlestickPatternBullishEngulfing:
+		return cr.evalBullishEngulfing(c1, c2)
+	case CandlestickPatternBearishEngulfing:
+		return cr.evalBearishEngulfing(c1, c2)
+	case CandlestickPatternBullishHarami:
+		return cr.evalBullishHarami(c1, c2)
+	case CandlestickPatternBearishHarami:
+		return cr.evalBearishHarami(c1, c2)
+	case CandlestickPatternPiercingLine:
+		return cr.evalPiercingLine(c1, c2)
+	case CandlestickPatternDarkCloudCover:
+		return cr.evalDarkCloudCover(c1, c2)
+	case CandlestickPatternTweezerTop:
+		return cr.evalTweezerTop(c1, c2)
+	case CandlestickPatternTweezerBottom:
+		return cr.evalTweezerBottom(c1, c2)
+	default:
+		return false
+	}
+}
+
+// evalTriple evaluates three candle patterns.
+func (cr CandlestickRecognizer) evalTriple(cp CandlestickPattern, c1, c2, c3 Candle) bool {
+	switch cp {
+	case CandlestickPatternMorningStar:
+		return cr.evalMorningStar(c1, c2, c3)
+	case CandlestickPatternEveningStar:
+		return cr.evalEveningStar(c1, c2, c3)
+	case CandlestickPatternThreeWhiteSoldiers:
+		return cr.evalThreeWhiteSoldiers(c1, c2, c3)
+	case CandlestickPatternThreeBlackCrows:
+		return cr.evalThreeBlackCrows(c1, c2, c3)
+	default:
+		return false
+	}
+}
+
+// Sentiment returns the market sentiment (direction) indicated by the
+// candlestick pattern. All supported patterns except dojis are reversal
+// patterns, so the sentiment is opposite to the trend preceding the
+// pattern. Zero value is returned for invalid patterns.
+func (cp CandlestickPattern) Sentiment() Sentiment {
+	if cp.Validate() != nil {
+		return 0
+	}
+
+	switch cp.precedingTrend() {
+	case TrendDown:
+		return SentimentBullish
+	case TrendUp:
+		return SentimentBearish
+	default:
+		return SentimentNeutral
+	}
+}
+
+// Name returns human-readable name of the candlestick pattern, e.g.
+// "Three White Soldiers". Empty string is returned for invalid patterns.
+func (cp CandlestickPattern) Name() string {
+	switch {
+	case cp.Validate() != nil:
+		return ""
+	case cp == CandlestickPatternLongLeggedDoji:
+		// hyphenated compound adjective.
+		return "Long-Legged Doji"
+	}
+
+	ww := strings.Split(string(cp), "-")
+
+	for i := range ww {
+		ww[i] = strings.ToUpper(ww[i][:1]) + ww[i][1:]
+	}
+
+	return strings.Join(ww, " ")
+}
+
+// Reliability returns how reliable the signal of the candlestick pattern
+// is generally considered to be. Zero value is returned for invalid
+// patterns.
+func (cp CandlestickPattern) Reliability() Reliability {
 	switch cp {
 	case CandlestickPatternHammer,
 		CandlestickPatternHangingMan,
@@ -75,133 +551,445 @@ func (cp CandlestickPattern) Count() int {
 		CandlestickPatternShootingStar,
 		CandlestickPatternLongLeggedDoji,
 		CandlestickPatternDragonflyDoji,
-		CandlestickPatternGravestoneDoji:
+		CandlestickPatternGravestoneDoji,
+		CandlestickPatternBullishHarami,
+		CandlestickPatternBearishHarami,
+		CandlestickPatternTweezerTop,
+		CandlestickPatternTweezerBottom:
 
-		return 1
+		return ReliabilityLow
+	case CandlestickPatternBullishEngulfing,
+		CandlestickPatternBearishEngulfing,
+		CandlestickPatternPiercingLine,
+		CandlestickPatternDarkCloudCover:
+
+		return ReliabilityMedium
+	case CandlestickPatternMorningStar,
+		CandlestickPatternEveningStar,
+		CandlestickPatternThreeWhiteSoldiers,
+		CandlestickPatternThreeBlackCrows:
+
+		return ReliabilityHigh
 	default:
 		return 0
 	}
 }
 
+// precedingTrend returns the trend that must precede the reversal
+// candlestick pattern. Zero value is returned for patterns that do not
+// depend on the preceding trend.
+func (cp CandlestickPattern) precedingTrend() Trend {
+	switch cp {
+	case CandlestickPatternHammer,
+		CandlestickPatternInvertedHammer,
+		CandlestickPatternBullishEngulfing,
+		CandlestickPatternBullishHarami,
+		CandlestickPatternPiercingLine,
+		CandlestickPatternTweezerBottom,
+		CandlestickPatternMorningStar,
+		CandlestickPatternThreeWhiteSoldiers:
+
+		return TrendDown
+	case CandlestickPatternHangingMan,
+		CandlestickPatternShootingStar,
+		CandlestickPatternBearishEngulfing,
+		CandlestickPatternBearishHarami,
+		CandlestickPatternDarkCloudCover,
+		CandlestickPatternTweezerTop,
+		CandlestickPatternEveningStar,
+		CandlestickPatternThreeBlackCrows:
+
+		return TrendUp
+	default:
+		return 0
+	}
+}
+
+// TrendDetector holds all the necessary information needed to determine
+// the trend of candles, e.g. the one preceding a candlestick pattern.
+// The trend is determined by the slope of the moving average of close
+// prices.
+// The zero value is not usable.
+type TrendDetector struct {
+	// valid specifies whether TrendDetector paremeters were validated.
+	valid bool
+
+	// ma specifies moving average indicator configuration.
+	ma MA
+}
+
+// NewTrendDetector validates provided configuration options and creates
+// new TrendDetector.
+func NewTrendDetector(mat MAType, length int) (TrendDetector, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return TrendDetector{}, err
+	}
+
+	return TrendDetector{
+		valid: true,
+		ma:    ma,
+	}, nil
+}
+
+// Calc determines the trend of the provided candles by comparing the
+// moving average of the most recent close prices with the moving average
+// one candle earlier. Zero value is returned when the moving average
+// does not change.
+func (td TrendDetector) Calc(cc []Candle) (Trend, error) {
+	if !td.valid {
+		return 0, ErrInvalidIndicator
+	}
+
+	if len(cc) != td.Count() {
+		return 0, ErrInvalidDataSize
+	}
+
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].Close
+	}
+
+	prev, err := td.ma.Calc(dd[:len(dd)-1])
+	if err != nil {
+		// unlikely to happen
+		return 0, err
+	}
+
+	curr, err := td.ma.Calc(dd[1:])
+	if err != nil {
+		// unlikely to happen
+		return 0, err
+	}
+
+	switch curr.Cmp(prev) {
+	case 1:
+		return TrendUp, nil
+	case -1:
+		return TrendDown, nil
+	default:
+		return 0, nil
+	}
+}
+
+// Count determines the total amount of candles needed for trend
+// detection.
+func (td TrendDetector) Count() int {
+	return td.ma.Count() + 1
+}
+
+// evalHammerShape evaluates whether the given candle has the shape shared
+// by the Hammer and Hanging Man candlestick patterns. The body, regardless
+// of its color, must be within the small body size bounds (5% to 20% of the
+// total candle size by default) and its top must be close to the high of
+// the candle within a certain leeway, leaving a long lower shadow.
+func (cr CandlestickRecognizer) evalHammerShape(c Candle) bool {
+	return isWithinCandleLeewayRange(
+		c.High,
+		c.Low,
+		c.High,
+		decimal.Max(c.Open, c.Close),
+		cr.opts.ShadowLeeway,
+	) && isWithinCandleBodySize(c, cr.opts.SmallBodyMax, cr.opts.SmallBodyMin)
+}
+
 // evalHammer evaluates whether the given candle matches the Hammer candlestick pattern.
-// The candle must be positive, the body must be less than 20% of the total candle size,
-// and greater than 5% of the total candle size. The close price must be
+// The candle must be positive, the body must be within the small body size
+// bounds (5% to 20% of the total candle size by default). The close price must be
 // close to the high of the candle within a certain leeway.
 // It is considered a bullish pattern.
-func evalHammer(c Candle) bool {
+func (cr CandlestickRecognizer) evalHammer(c Candle) bool {
 	return isWithinCandleLeewayRange(
 		c.High,
 		c.Low,
 		c.High,
 		c.Close,
-		decimal.NewFromFloat(0.10),
-	) && c.Open.LessThan(c.High) &&
-		isWithinCandleBodySize(c, decimal.NewFromFloat(0.2), decimal.NewFromFloat(0.05))
+		cr.opts.ShadowLeeway,
+	) && c.Open.LessThan(c.Close) &&
+		isWithinCandleBodySize(c, cr.opts.SmallBodyMax, cr.opts.SmallBodyMin)
 }
 
 // evalHangingMan evaluates whether the given candle matches the Hanging Man candlestick pattern.
-// The candle must be negative, the body must be less than 20% of the total candle size,
-// and greater than 5% of the total candle size. The open price must be
+// The candle must be negative, the body must be within the small body size
+// bounds (5% to 20% of the total candle size by default). The open price must be
 // close to the high of the candle within a certain leeway.
 // It is considered a bearish pattern.
-func evalHangingMan(c Candle) bool {
+func (cr CandlestickRecognizer) evalHangingMan(c Candle) bool {
 	return isWithinCandleLeewayRange(
 		c.High,
 		c.Low,
 		c.High,
 		c.Open,
-		decimal.NewFromFloat(0.10),
+		cr.opts.ShadowLeeway,
 	) && c.Close.LessThan(c.Open) &&
-		isWithinCandleBodySize(c, decimal.NewFromFloat(0.2), decimal.NewFromFloat(0.05))
+		isWithinCandleBodySize(c, cr.opts.SmallBodyMax, cr.opts.SmallBodyMin)
+}
+
+// evalInvertedHammerShape evaluates whether the given candle has the shape
+// shared by the Inverted Hammer and Shooting Star candlestick patterns. The
+// body, regardless of its color, must be within the small body size bounds
+// (5% to 20% of the total candle size by default) and its bottom must be
+// close to the low of the candle within a certain leeway, leaving a long
+// upper shadow.
+func (cr CandlestickRecognizer) evalInvertedHammerShape(c Candle) bool {
+	return isWithinCandleLeewayRange(
+		c.High,
+		c.Low,
+		c.Low,
+		decimal.Min(c.Open, c.Close),
+		cr.opts.ShadowLeeway,
+	) && isWithinCandleBodySize(c, cr.opts.SmallBodyMax, cr.opts.SmallBodyMin)
 }
 
 // evalInvertedHammer evaluates whether the given candle matches the Inverted Hammer candlestick pattern.
-// The candle must be positive, the body must be less than 20% of the total candle size,
-// and greater than 5% of the total candle size. The open price must be
+// The candle must be positive, the body must be within the small body size
+// bounds (5% to 20% of the total candle size by default). The open price must be
 // close to the low of the candle within a certain leeway.
 // It is considered a bullish pattern.
-func evalInvertedHammer(c Candle) bool {
+func (cr CandlestickRecognizer) evalInvertedHammer(c Candle) bool {
 	return isWithinCandleLeewayRange(
 		c.High,
 		c.Low,
 		c.Low,
 		c.Open,
-		decimal.NewFromFloat(0.10),
-	) && c.Close.GreaterThan(c.Low) &&
-		isWithinCandleBodySize(c, decimal.NewFromFloat(0.2), decimal.NewFromFloat(0.05))
+		cr.opts.ShadowLeeway,
+	) && c.Close.GreaterThan(c.Open) &&
+		isWithinCandleBodySize(c, cr.opts.SmallBodyMax, cr.opts.SmallBodyMin)
 }
 
 // evalShootingStar evaluates whether the given candle matches the Shooting Star candlestick pattern.
-// The candle must be negative, the body must be less than 20% of the total candle size,
-// and greater than 5% of the total candle size. The close price must be
+// The candle must be negative, the body must be within the small body size
+// bounds (5% to 20% of the total candle size by default). The close price must be
 // close to the low of the candle within a certain leeway.
 // It is considered a bearish pattern.
-func evalShootingStar(c Candle) bool {
+func (cr CandlestickRecognizer) evalShootingStar(c Candle) bool {
 	return isWithinCandleLeewayRange(
 		c.High,
 		c.Low,
 		c.Low,
 		c.Close,
-		decimal.NewFromFloat(0.10),
-	) && c.Open.GreaterThan(c.Low) &&
-		isWithinCandleBodySize(c, decimal.NewFromFloat(0.2), decimal.NewFromFloat(0.05))
+		cr.opts.ShadowLeeway,
+	) && c.Open.GreaterThan(c.Close) &&
+		isWithinCandleBodySize(c, cr.opts.SmallBodyMax, cr.opts.SmallBodyMin)
 }
 
 // evalLongLeggedDoji evaluates whether the given candle matches the Long-Legged Doji candlestick pattern.
 // The candle must have a close price that is in the middle of the high and low prices,
-// and the body size must be less than 5% of the total candle size.
+// and the body size must not exceed the doji body size (5% of the total candle size by default).
 // It is considered a neutral pattern.
-func evalLongLeggedDoji(c Candle) bool {
+func (cr CandlestickRecognizer) evalLongLeggedDoji(c Candle) bool {
 	return isWithinCandleLeewayRange(
 		c.High,
 		c.Low,
 		c.High.Add(c.Low).Div(decimal.NewFromInt(2)),
 		c.Close,
-		decimal.NewFromFloat(0.05),
-	) && isWithinCandleBodySize(c, decimal.NewFromFloat(0.05), decimal.NewFromFloat(0))
+		cr.opts.DojiLeeway,
+	) && isWithinCandleBodySize(c, cr.opts.DojiBodyMax, decimal.Zero)
 }
 
 // evalDragonflyDoji evaluates whether the given candle matches the Dragonfly Doji candlestick pattern.
 // The candle must have a close price that is near the high of the candle,
-// and the body size must be less than 5% of the total candle size.
+// and the body size must not exceed the doji body size (5% of the total candle size by default).
 // It is considered a neutral pattern.
-func evalDragonflyDoji(c Candle) bool {
+func (cr CandlestickRecognizer) evalDragonflyDoji(c Candle) bool {
 	return isWithinCandleLeewayRange(
 		c.High,
 		c.Low,
 		c.High,
 		c.Close,
-		decimal.NewFromFloat(0.05),
-	) && isWithinCandleBodySize(c, decimal.NewFromFloat(0.05), decimal.NewFromFloat(0))
+		cr.opts.DojiLeeway,
+	) && isWithinCandleBodySize(c, cr.opts.DojiBodyMax, decimal.Zero)
 }
 
 // evalGravestoneDoji evaluates whether the given candle matches the Gravestone Doji candlestick pattern.
 // The candle must have a close price that is near the low of the candle,
-// and the body size must be less than 5% of the total candle size.
+// and the body size must not exceed the doji body size (5% of the total candle size by default).
 // It is considered a neutral pattern.
-func evalGravestoneDoji(c Candle) bool {
+func (cr CandlestickRecognizer) evalGravestoneDoji(c Candle) bool {
 	return isWithinCandleLeewayRange(
 		c.High,
 		c.Low,
 		c.Low,
 		c.Close,
-		decimal.NewFromFloat(0.05),
-	) && isWithinCandleBodySize(c, decimal.NewFromFloat(0.05), decimal.NewFromFloat(0))
+		cr.opts.DojiLeeway,
+	) && isWithinCandleBodySize(c, cr.opts.DojiBodyMax, decimal.Zero)
+}
+
+// evalBullishEngulfing evaluates whether the given candles match the Bullish Engulfing candlestick pattern.
+// The first candle must be negative, the second one must be positive and its body must
+// completely engulf the body of the first candle.
+// It is considered a bullish pattern.
+func (cr CandlestickRecognizer) evalBullishEngulfing(c1, c2 Candle) bool {
+	return isBearishCandle(c1) && isBullishCandle(c2) &&
+		c2.Open.LessThanOrEqual(c1.Close) && c2.Close.GreaterThanOrEqual(c1.Open) &&
+		candleBody(c2).GreaterThan(candleBody(c1))
+}
+
+// evalBearishEngulfing evaluates whether the given candles match the Bearish Engulfing candlestick pattern.
+// The first candle must be positive, the second one must be negative and its body must
+// completely engulf the body of the first candle.
+// It is considered a bearish pattern.
+func (cr CandlestickRecognizer) evalBearishEngulfing(c1, c2 Candle) bool {
+	return isBullishCandle(c1) && isBearishCandle(c2) &&
+		c2.Open.GreaterThanOrEqual(c1.Close) && c2.Close.LessThanOrEqual(c1.Open) &&
+		candleBody(c2).GreaterThan(candleBody(c1))
+}
+
+// evalBullishHarami evaluates whether the given candles match the Bullish Harami candlestick pattern.
+// The first candle must be negative, the second one must be positive and its body must
+// be completely contained within the body of the first candle.
+// It is considered a bullish pattern.
+func (cr CandlestickRecognizer) evalBullishHarami(c1, c2 Candle) bool {
+	return isBearishCandle(c1) && isBullishCandle(c2) &&
+		c2.Open.GreaterThanOrEqual(c1.Close) && c2.Close.LessThanOrEqual(c1.Open) &&
+		candleBody(c2).LessThan(candleBody(c1))
+}
+
+// evalBearishHarami evaluates whether the given candles match the Bearish Harami candlestick pattern.
+// The first candle must be positive, the second one must be negative and its body must
+// be completely contained within the body of the first candle.
+// It is considered a bearish pattern.
+func (cr CandlestickRecognizer) evalBearishHarami(c1, c2 Candle) bool {
+	return isBullishCandle(c1) && isBearishCandle(c2) &&
+		c2.Open.LessThanOrEqual(c1.Close) && c2.Close.GreaterThanOrEqual(c1.Open) &&
+		candleBody(c2).LessThan(candleBody(c1))
+}
+
+// evalPiercingLine evaluates whether the given candles match the Piercing Line candlestick pattern.
+// The first candle must be negative, the second one must be positive, open below the close
+// of the first candle and close above the middle of the first candle's body, but below its open.
+// It is considered a bullish pattern.
+func (cr CandlestickRecognizer) evalPiercingLine(c1, c2 Candle) bool {
+	return isBearishCandle(c1) && isBullishCandle(c2) &&
+		c2.Open.LessThan(c1.Close) &&
+		c2.Close.GreaterThan(candleBodyMiddle(c1)) && c2.Close.LessThan(c1.Open)
+}
+
+// evalDarkCloudCover evaluates whether the given candles match the Dark Cloud Cover candlestick pattern.
+// The first candle must be positive, the second one must be negative, open above the close
+// of the first candle and close below the middle of the first candle's body, but above its open.
+// It is considered a bearish pattern.
+func (cr CandlestickRecognizer) evalDarkCloudCover(c1, c2 Candle) bool {
+	return isBullishCandle(c1) && isBearishCandle(c2) &&
+		c2.Open.GreaterThan(c1.Close) &&
+		c2.Close.LessThan(candleBodyMiddle(c1)) && c2.Close.GreaterThan(c1.Open)
 }
 
-// Candle represents a single candlestick in a financial chart.
-type Candle struct {
-	// Open  is the opening price of the candle.
-	Open decimal.Decimal
+// evalTweezerTop evaluates whether the given candles match the Tweezer Top candlestick pattern.
+// The first candle must be positive, the second one must be negative and both candles
+// must have the same high within a certain leeway.
+// It is considered a bearish pattern.
+func (cr CandlestickRecognizer) evalTweezerTop(c1, c2 Candle) bool {
+	return isBullishCandle(c1) && isBearishCandle(c2) &&
+		isWithinCandleLeewayRange(
+			c1.High,
+			c1.Low,
+			c1.High,
+			c2.High,
+			cr.opts.TweezerLeeway,
+		)
+}
 
-	// High  is the highest price of the candle.
-	High decimal.Decimal
+// evalTweezerBottom evaluates whether the given candles match the Tweezer Bottom candlestick pattern.
+// The first candle must be negative, the second one must be positive and both candles
+// must have the same low within a certain leeway.
+// It is considered a bullish pattern.
+func (cr CandlestickRecognizer) evalTweezerBottom(c1, c2 Candle) bool {
+	return isBearishCandle(c1) && isBullishCandle(c2) &&
+		isWithinCandleLeewayRange(
+			c1.High,
+			c1.Low,
+			c1.Low,
+			c2.Low,
+			cr.opts.TweezerLeeway,
+		)
+}
 
-	// Low   is the lowest price of the candle.
-	Low decimal.Decimal
+// evalMorningStar evaluates whether the given candles match the Morning Star candlestick pattern.
+// The first candle must be negative with the long body (at least 50% of the total candle size by default),
+// the second candle's body must not exceed the star body size (30% by default) and be below the first
+// candle's body, and the third candle must be positive and close above the middle of the
+// first candle's body.
+// It is considered a bullish pattern.
+func (cr CandlestickRecognizer) evalMorningStar(c1, c2, c3 Candle) bool {
+	return isBearishCandle(c1) &&
+		isWithinCandleBodySize(c1, decimal.NewFromInt(1), cr.opts.LongBodyMin) &&
+		isWithinCandleBodySize(c2, cr.opts.StarBodyMax, decimal.Zero) &&
+		decimal.Max(c2.Open, c2.Close).LessThanOrEqual(c1.Close) &&
+		isBullishCandle(c3) && c3.Close.GreaterThan(candleBodyMiddle(c1))
+}
+
+// evalEveningStar evaluates whether the given candles match the Evening Star candlestick pattern.
+// The first candle must be positive with the long body (at least 50% of the total candle size by default),
+// the second candle's body must not exceed the star body size (30% by default) and be above the first
+// candle's body, and the third candle must be negative and close below the middle of the
+// first candle's body.
+// It is considered a bearish pattern.
+func (cr CandlestickRecognizer) evalEveningStar(c1, c2, c3 Candle) bool {
+	return isBullishCandle(c1) &&
+		isWithinCandleBodySize(c1, decimal.NewFromInt(1), cr.opts.LongBodyMin) &&
+		isWithinCandleBodySize(c2, cr.opts.StarBodyMax, decimal.Zero) &&
+		decimal.Min(c2.Open, c2.Close).GreaterThanOrEqual(c1.Close) &&
+		isBearishCandle(c3) && c3.Close.LessThan(candleBodyMiddle(c1))
+}
+
+// evalThreeWhiteSoldiers evaluates whether the given candles match the Three White Soldiers
+// candlestick pattern. All candles must be positive with the long body (at least 50% of the
+// total candle size by default), each candle must open within the previous candle's body and close above
+// the previous candle's close.
+// It is considered a bullish pattern.
+func (cr CandlestickRecognizer) evalThreeWhiteSoldiers(c1, c2, c3 Candle) bool {
+	return cr.isSoldier(c1, c2) && cr.isSoldier(c2, c3) &&
+		isWithinCandleBodySize(c1, decimal.NewFromInt(1), cr.opts.LongBodyMin)
+}
+
+// evalThreeBlackCrows evaluates whether the given candles match the Three Black Crows
+// candlestick pattern. All candles must be negative with the long body (at least 50% of the
+// total candle size by default), each candle must open within the previous candle's body and close below
+// the previous candle's close.
+// It is considered a bearish pattern.
+func (cr CandlestickRecognizer) evalThreeBlackCrows(c1, c2, c3 Candle) bool {
+	return cr.isCrow(c1, c2) && cr.isCrow(c2, c3) &&
+		isWithinCandleBodySize(c1, decimal.NewFromInt(1), cr.opts.LongBodyMin)
+}
+
+// isSoldier checks whether both candles are long positive candles and the
+// current one opens within the previous candle's body and closes above it.
+func (cr CandlestickRecognizer) isSoldier(prev, curr Candle) bool {
+	return isBullishCandle(prev) && isBullishCandle(curr) &&
+		curr.Open.GreaterThan(prev.Open) && curr.Open.LessThanOrEqual(prev.Close) &&
+		curr.Close.GreaterThan(prev.Close) &&
+		isWithinCandleBodySize(curr, decimal.NewFromInt(1), cr.opts.LongBodyMin)
+}
+
+// isCrow checks whether both candles are long negative candles and the
+// current one opens within the previous candle's body and closes below it.
+func (cr CandlestickRecognizer) isCrow(prev, curr Candle) bool {
+	return isBearishCandle(prev) && isBearishCandle(curr) &&
+		curr.Open.LessThan(prev.Open) && curr.Open.GreaterThanOrEqual(prev.Close) &&
+		curr.Close.LessThan(prev.Close) &&
+		isWithinCandleBodySize(curr, decimal.NewFromInt(1), cr.opts.LongBodyMin)
+}
+
+// isBullishCandle checks whether the candle closed above its open.
+func isBullishCandle(c Candle) bool {
+	return c.Close.GreaterThan(c.Open)
+}
+
+// isBearishCandle checks whether the candle closed below its open.
+func isBearishCandle(c Candle) bool {
+	return c.Close.LessThan(c.Open)
+}
+
+// candleBody calculates the size of the candle's body.
+func candleBody(c Candle) decimal.Decimal {
+	return c.Close.Sub(c.Open).Abs()
+}
 
-	// Close is the closing price of the candle.
-	Close decimal.Decimal
+// candleBodyMiddle calculates the middle point of the candle's body.
+func candleBodyMiddle(c Candle) decimal.Decimal {
+	return c.Open.Add(c.Close).Div(decimal.NewFromInt(2))
 }
 
 // isWithinCandleLeewayRange checks whether the actual value is within the
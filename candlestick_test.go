@@ -1,6 +1,7 @@
 package tango
 
 import (
+	"encoding/json"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -16,6 +17,18 @@ func Test_CandlestickPattern_Validate(t *testing.T) {
 		CandlestickPatternLongLeggedDoji,
 		CandlestickPatternDragonflyDoji,
 		CandlestickPatternGravestoneDoji,
+		CandlestickPatternBullishEngulfing,
+		CandlestickPatternBearishEngulfing,
+		CandlestickPatternBullishHarami,
+		CandlestickPatternBearishHarami,
+		CandlestickPatternPiercingLine,
+		CandlestickPatternDarkCloudCover,
+		CandlestickPatternTweezerTop,
+		CandlestickPatternTweezerBottom,
+		CandlestickPatternMorningStar,
+		CandlestickPatternEveningStar,
+		CandlestickPatternThreeWhiteSoldiers,
+		CandlestickPatternThreeBlackCrows,
 	}
 
 	for _, pattern := range patterns {
@@ -83,6 +96,28 @@ func Test_CandlestickPattern_Eval(t *testing.T) {
 			},
 			Result: true,
 		},
+		"Hammer pattern not matched by negative candle": {
+			Pattern: CandlestickPatternHammer,
+			Candles: []Candle{
+				{
+					High:  decimal.NewFromFloat(100),
+					Close: decimal.NewFromFloat(90),
+					Open:  decimal.NewFromFloat(100),
+					Low:   decimal.NewFromFloat(40),
+				},
+			},
+		},
+		"Hanging man pattern not matched by positive candle": {
+			Pattern: CandlestickPatternHangingMan,
+			Candles: []Candle{
+				{
+					High:  decimal.NewFromFloat(100),
+					Close: decimal.NewFromFloat(100),
+					Open:  decimal.NewFromFloat(90),
+					Low:   decimal.NewFromFloat(40),
+				},
+			},
+		},
 		"Successfully evaluated inverted hammer pattern with some leeway": {
 			Pattern: CandlestickPatternInvertedHammer,
 			Candles: []Candle{
@@ -131,6 +166,28 @@ func Test_CandlestickPattern_Eval(t *testing.T) {
 			},
 			Result: true,
 		},
+		"Inverted hammer pattern not matched by negative candle": {
+			Pattern: CandlestickPatternInvertedHammer,
+			Candles: []Candle{
+				{
+					High:  decimal.NewFromFloat(100),
+					Close: decimal.NewFromFloat(40),
+					Open:  decimal.NewFromFloat(50),
+					Low:   decimal.NewFromFloat(40),
+				},
+			},
+		},
+		"Shooting star pattern not matched by positive candle": {
+			Pattern: CandlestickPatternShootingStar,
+			Candles: []Candle{
+				{
+					High:  decimal.NewFromFloat(100),
+					Close: decimal.NewFromFloat(50),
+					Open:  decimal.NewFromFloat(40),
+					Low:   decimal.NewFromFloat(40),
+				},
+			},
+		},
 		"Successfully evaluated long legged doji pattern with some leeway": {
 			Pattern: CandlestickPatternLongLeggedDoji,
 			Candles: []Candle{
@@ -203,6 +260,360 @@ func Test_CandlestickPattern_Eval(t *testing.T) {
 			},
 			Result: true,
 		},
+		"Successfully evaluated bullish engulfing pattern": {
+			Pattern: CandlestickPatternBullishEngulfing,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(20),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(14),
+					Close: decimal.NewFromFloat(15),
+				},
+				{
+					Open:  decimal.NewFromFloat(14),
+					High:  decimal.NewFromFloat(23),
+					Low:   decimal.NewFromFloat(13),
+					Close: decimal.NewFromFloat(22),
+				},
+			},
+			Result: true,
+		},
+		"Unsuccessfully evaluated bullish engulfing pattern": {
+			Pattern: CandlestickPatternBullishEngulfing,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(20),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(14),
+					Close: decimal.NewFromFloat(15),
+				},
+				{
+					Open:  decimal.NewFromFloat(16),
+					High:  decimal.NewFromFloat(23),
+					Low:   decimal.NewFromFloat(13),
+					Close: decimal.NewFromFloat(19),
+				},
+			},
+		},
+		"Successfully evaluated bearish engulfing pattern": {
+			Pattern: CandlestickPatternBearishEngulfing,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(15),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(14),
+					Close: decimal.NewFromFloat(20),
+				},
+				{
+					Open:  decimal.NewFromFloat(21),
+					High:  decimal.NewFromFloat(22),
+					Low:   decimal.NewFromFloat(13),
+					Close: decimal.NewFromFloat(14),
+				},
+			},
+			Result: true,
+		},
+		"Successfully evaluated bullish harami pattern": {
+			Pattern: CandlestickPatternBullishHarami,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(22),
+					High:  decimal.NewFromFloat(23),
+					Low:   decimal.NewFromFloat(13),
+					Close: decimal.NewFromFloat(14),
+				},
+				{
+					Open:  decimal.NewFromFloat(16),
+					High:  decimal.NewFromFloat(20),
+					Low:   decimal.NewFromFloat(15),
+					Close: decimal.NewFromFloat(19),
+				},
+			},
+			Result: true,
+		},
+		"Unsuccessfully evaluated bullish harami pattern": {
+			Pattern: CandlestickPatternBullishHarami,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(22),
+					High:  decimal.NewFromFloat(23),
+					Low:   decimal.NewFromFloat(13),
+					Close: decimal.NewFromFloat(14),
+				},
+				{
+					Open:  decimal.NewFromFloat(12),
+					High:  decimal.NewFromFloat(24),
+					Low:   decimal.NewFromFloat(11),
+					Close: decimal.NewFromFloat(23),
+				},
+			},
+		},
+		"Successfully evaluated bearish harami pattern": {
+			Pattern: CandlestickPatternBearishHarami,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(14),
+					High:  decimal.NewFromFloat(23),
+					Low:   decimal.NewFromFloat(13),
+					Close: decimal.NewFromFloat(22),
+				},
+				{
+					Open:  decimal.NewFromFloat(19),
+					High:  decimal.NewFromFloat(20),
+					Low:   decimal.NewFromFloat(15),
+					Close: decimal.NewFromFloat(16),
+				},
+			},
+			Result: true,
+		},
+		"Successfully evaluated piercing line pattern": {
+			Pattern: CandlestickPatternPiercingLine,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(20),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(10),
+				},
+				{
+					Open:  decimal.NewFromFloat(8),
+					High:  decimal.NewFromFloat(18),
+					Low:   decimal.NewFromFloat(7),
+					Close: decimal.NewFromFloat(17),
+				},
+			},
+			Result: true,
+		},
+		"Unsuccessfully evaluated piercing line pattern": {
+			Pattern: CandlestickPatternPiercingLine,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(20),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(10),
+				},
+				{
+					Open:  decimal.NewFromFloat(8),
+					High:  decimal.NewFromFloat(14),
+					Low:   decimal.NewFromFloat(7),
+					Close: decimal.NewFromFloat(13),
+				},
+			},
+		},
+		"Successfully evaluated dark cloud cover pattern": {
+			Pattern: CandlestickPatternDarkCloudCover,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(10),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(20),
+				},
+				{
+					Open:  decimal.NewFromFloat(22),
+					High:  decimal.NewFromFloat(23),
+					Low:   decimal.NewFromFloat(12),
+					Close: decimal.NewFromFloat(13),
+				},
+			},
+			Result: true,
+		},
+		"Successfully evaluated tweezer top pattern": {
+			Pattern: CandlestickPatternTweezerTop,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(10),
+					High:  decimal.NewFromFloat(20),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(19),
+				},
+				{
+					Open:  decimal.NewFromFloat(19),
+					High:  decimal.NewFromFloat(20.5),
+					Low:   decimal.NewFromFloat(10),
+					Close: decimal.NewFromFloat(11),
+				},
+			},
+			Result: true,
+		},
+		"Unsuccessfully evaluated tweezer top pattern": {
+			Pattern: CandlestickPatternTweezerTop,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(10),
+					High:  decimal.NewFromFloat(20),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(19),
+				},
+				{
+					Open:  decimal.NewFromFloat(19),
+					High:  decimal.NewFromFloat(25),
+					Low:   decimal.NewFromFloat(10),
+					Close: decimal.NewFromFloat(11),
+				},
+			},
+		},
+		"Successfully evaluated tweezer bottom pattern": {
+			Pattern: CandlestickPatternTweezerBottom,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(19),
+					High:  decimal.NewFromFloat(20),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(10),
+				},
+				{
+					Open:  decimal.NewFromFloat(10),
+					High:  decimal.NewFromFloat(19),
+					Low:   decimal.NewFromFloat(9.2),
+					Close: decimal.NewFromFloat(18),
+				},
+			},
+			Result: true,
+		},
+		"Successfully evaluated morning star pattern": {
+			Pattern: CandlestickPatternMorningStar,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(20),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(10),
+				},
+				{
+					Open:  decimal.NewFromFloat(9),
+					High:  decimal.NewFromFloat(10),
+					Low:   decimal.NewFromFloat(7),
+					Close: decimal.NewFromFloat(8.5),
+				},
+				{
+					Open:  decimal.NewFromFloat(9),
+					High:  decimal.NewFromFloat(19),
+					Low:   decimal.NewFromFloat(8.5),
+					Close: decimal.NewFromFloat(18),
+				},
+			},
+			Result: true,
+		},
+		"Unsuccessfully evaluated morning star pattern": {
+			Pattern: CandlestickPatternMorningStar,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(20),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(10),
+				},
+				{
+					Open:  decimal.NewFromFloat(9),
+					High:  decimal.NewFromFloat(10),
+					Low:   decimal.NewFromFloat(7),
+					Close: decimal.NewFromFloat(8.5),
+				},
+				{
+					Open:  decimal.NewFromFloat(9),
+					High:  decimal.NewFromFloat(14),
+					Low:   decimal.NewFromFloat(8.5),
+					Close: decimal.NewFromFloat(13),
+				},
+			},
+		},
+		"Successfully evaluated evening star pattern": {
+			Pattern: CandlestickPatternEveningStar,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(10),
+					High:  decimal.NewFromFloat(21),
+					Low:   decimal.NewFromFloat(9),
+					Close: decimal.NewFromFloat(20),
+				},
+				{
+					Open:  decimal.NewFromFloat(21),
+					High:  decimal.NewFromFloat(23),
+					Low:   decimal.NewFromFloat(20),
+					Close: decimal.NewFromFloat(21.5),
+				},
+				{
+					Open:  decimal.NewFromFloat(21),
+					High:  decimal.NewFromFloat(21.5),
+					Low:   decimal.NewFromFloat(11),
+					Close: decimal.NewFromFloat(12),
+				},
+			},
+			Result: true,
+		},
+		"Successfully evaluated three white soldiers pattern": {
+			Pattern: CandlestickPatternThreeWhiteSoldiers,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(10),
+					High:  decimal.NewFromFloat(14.5),
+					Low:   decimal.NewFromFloat(9.5),
+					Close: decimal.NewFromFloat(14),
+				},
+				{
+					Open:  decimal.NewFromFloat(12),
+					High:  decimal.NewFromFloat(17.5),
+					Low:   decimal.NewFromFloat(11.5),
+					Close: decimal.NewFromFloat(17),
+				},
+				{
+					Open:  decimal.NewFromFloat(15),
+					High:  decimal.NewFromFloat(20.5),
+					Low:   decimal.NewFromFloat(14.5),
+					Close: decimal.NewFromFloat(20),
+				},
+			},
+			Result: true,
+		},
+		"Unsuccessfully evaluated three white soldiers pattern": {
+			Pattern: CandlestickPatternThreeWhiteSoldiers,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(10),
+					High:  decimal.NewFromFloat(14.5),
+					Low:   decimal.NewFromFloat(9.5),
+					Close: decimal.NewFromFloat(14),
+				},
+				{
+					Open:  decimal.NewFromFloat(12),
+					High:  decimal.NewFromFloat(17.5),
+					Low:   decimal.NewFromFloat(11.5),
+					Close: decimal.NewFromFloat(17),
+				},
+				{
+					Open:  decimal.NewFromFloat(15),
+					High:  decimal.NewFromFloat(20.5),
+					Low:   decimal.NewFromFloat(14.5),
+					Close: decimal.NewFromFloat(16),
+				},
+			},
+		},
+		"Successfully evaluated three black crows pattern": {
+			Pattern: CandlestickPatternThreeBlackCrows,
+			Candles: []Candle{
+				{
+					Open:  decimal.NewFromFloat(20),
+					High:  decimal.NewFromFloat(20.5),
+					Low:   decimal.NewFromFloat(15.5),
+					Close: decimal.NewFromFloat(16),
+				},
+				{
+					Open:  decimal.NewFromFloat(18),
+					High:  decimal.NewFromFloat(18.5),
+					Low:   decimal.NewFromFloat(12.5),
+					Close: decimal.NewFromFloat(13),
+				},
+				{
+					Open:  decimal.NewFromFloat(15),
+					High:  decimal.NewFromFloat(15.5),
+					Low:   decimal.NewFromFloat(9.5),
+					Close: decimal.NewFromFloat(10),
+				},
+			},
+			Result: true,
+		},
 	}
 
 	for cn, c := range cc {
@@ -215,3 +626,516 @@ func Test_CandlestickPattern_Eval(t *testing.T) {
 		})
 	}
 }
+
+func Test_CandlestickPattern_Count(t *testing.T) {
+	assert.Equal(t, 0, CandlestickPattern("invalid").Count())
+	assert.Equal(t, 1, CandlestickPatternHammer.Count())
+	assert.Equal(t, 2, CandlestickPatternBullishEngulfing.Count())
+	assert.Equal(t, 3, CandlestickPatternMorningStar.Count())
+}
+
+func Test_CandlestickOptions_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Options func(*CandlestickOptions)
+		Error   error
+	}{
+		"Negative ratio": {
+			Options: func(opts *CandlestickOptions) {
+				opts.DojiLeeway = decimal.NewFromInt(-1)
+			},
+			Error: ErrInvalidCandlestickOptions,
+		},
+		"Ratio above 1": {
+			Options: func(opts *CandlestickOptions) {
+				opts.LongBodyMin = decimal.NewFromFloat(1.5)
+			},
+			Error: ErrInvalidCandlestickOptions,
+		},
+		"Minimum small body size exceeds the maximum": {
+			Options: func(opts *CandlestickOptions) {
+				opts.SmallBodyMin = decimal.NewFromFloat(0.3)
+			},
+			Error: ErrInvalidCandlestickOptions,
+		},
+		"Successfully validated": {
+			Options: func(_ *CandlestickOptions) {},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			opts := DefaultCandlestickOptions()
+			c.Options(&opts)
+
+			assertEqualError(t, c.Error, opts.Validate())
+		})
+	}
+}
+
+func Test_CandlestickOptions_JSON(t *testing.T) {
+	opts := DefaultCandlestickOptions()
+
+	d, err := json.Marshal(opts)
+	assert.NoError(t, err)
+
+	res := DefaultCandlestickOptions()
+	assert.NoError(t, json.Unmarshal([]byte(`{"shadow_leeway":"0.2"}`), &res))
+	assert.Equal(t, "0.2", res.ShadowLeeway.String())
+	assert.Equal(t, opts.SmallBodyMax.String(), res.SmallBodyMax.String())
+
+	res = CandlestickOptions{}
+	assert.NoError(t, json.Unmarshal(d, &res))
+	assert.Equal(t, opts.TweezerLeeway.String(), res.TweezerLeeway.String())
+	assert.Equal(t, opts.StarBodyMax.String(), res.StarBodyMax.String())
+}
+
+func Test_NewCandlestickRecognizer(t *testing.T) {
+	opts := DefaultCandlestickOptions()
+	opts.DojiBodyMax = decimal.NewFromInt(2)
+
+	_, err := NewCandlestickRecognizer(opts)
+	assertEqualError(t, ErrInvalidCandlestickOptions, err)
+
+	cr, err := NewCandlestickRecognizer(DefaultCandlestickOptions())
+	assert.NoError(t, err)
+	assert.True(t, cr.valid)
+	assert.Equal(t, DefaultCandlestickOptions(), cr.Options())
+}
+
+func Test_CandlestickRecognizer_Eval(t *testing.T) {
+	hammer := []Candle{
+		{
+			High:  decimal.NewFromFloat(100),
+			Close: decimal.NewFromFloat(85),
+			Open:  decimal.NewFromFloat(70),
+			Low:   decimal.NewFromFloat(20),
+		},
+	}
+
+	loose := DefaultCandlestickOptions()
+	loose.ShadowLeeway = decimal.NewFromFloat(0.2)
+
+	cc := map[string]struct {
+		Recognizer CandlestickRecognizer
+		Pattern    CandlestickPattern
+		Candles    []Candle
+		Result     bool
+		Error      error
+	}{
+		"Invalid recognizer": {
+			Pattern: CandlestickPatternHammer,
+			Candles: hammer,
+			Error:   ErrInvalidIndicator,
+		},
+		"Invalid pattern": {
+			Recognizer: CandlestickRecognizer{valid: true, opts: DefaultCandlestickOptions()},
+			Pattern:    CandlestickPattern("invalid"),
+			Candles:    hammer,
+			Error:      ErrInvalidCandlestickPattern,
+		},
+		"Invalid candle count": {
+			Recognizer: CandlestickRecognizer{valid: true, opts: DefaultCandlestickOptions()},
+			Pattern:    CandlestickPatternBullishEngulfing,
+			Candles:    hammer,
+			Error:      ErrInvalidDataSize,
+		},
+		"Pattern not matched with default options": {
+			Recognizer: CandlestickRecognizer{valid: true, opts: DefaultCandlestickOptions()},
+			Pattern:    CandlestickPatternHammer,
+			Candles:    hammer,
+		},
+		"Pattern matched with custom options": {
+			Recognizer: CandlestickRecognizer{valid: true, opts: loose},
+			Pattern:    CandlestickPatternHammer,
+			Candles:    hammer,
+			Result:     true,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Recognizer.Eval(c.Pattern, c.Candles)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewTrendDetector(t *testing.T) {
+	_, err := NewTrendDetector(0, 3)
+	assertEqualError(t, ErrInvalidMA, err)
+
+	res, err := NewTrendDetector(MATypeSimple, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, TrendDetector{
+		valid: true,
+		ma: SMA{
+			valid:  true,
+			length: 3,
+		},
+	}, res)
+}
+
+func Test_TrendDetector_Calc(t *testing.T) {
+	td := TrendDetector{valid: true, ma: SMA{valid: true, length: 2}}
+
+	cc := map[string]struct {
+		TrendDetector TrendDetector
+		Candles       []Candle
+		Result        Trend
+		Error         error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			TrendDetector: td,
+			Candles:       trendCandles(1, 2),
+			Error:         ErrInvalidDataSize,
+		},
+		"Successfully detected uptrend": {
+			TrendDetector: td,
+			Candles:       trendCandles(1, 2, 3),
+			Result:        TrendUp,
+		},
+		"Successfully detected downtrend": {
+			TrendDetector: td,
+			Candles:       trendCandles(3, 2, 1),
+			Result:        TrendDown,
+		},
+		"No trend": {
+			TrendDetector: td,
+			Candles:       trendCandles(2, 1, 2),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TrendDetector.Calc(c.Candles)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_TrendDetector_Count(t *testing.T) {
+	assert.Equal(t, 4, TrendDetector{ma: SMA{length: 3}}.Count())
+}
+
+func Test_CandlestickRecognizer_EvalTrend(t *testing.T) {
+	cr := CandlestickRecognizer{valid: true, opts: DefaultCandlestickOptions()}
+	td := TrendDetector{valid: true, ma: SMA{valid: true, length: 2}}
+
+	hammer := Candle{
+		High:  decimal.NewFromFloat(100),
+		Close: decimal.NewFromFloat(100),
+		Open:  decimal.NewFromFloat(90),
+		Low:   decimal.NewFromFloat(40),
+	}
+
+	hangingMan := Candle{
+		High:  decimal.NewFromFloat(100),
+		Close: decimal.NewFromFloat(90),
+		Open:  decimal.NewFromFloat(100),
+		Low:   decimal.NewFromFloat(40),
+	}
+
+	doji := Candle{
+		High:  decimal.NewFromFloat(100),
+		Close: decimal.NewFromFloat(70),
+		Open:  decimal.NewFromFloat(70),
+		Low:   decimal.NewFromFloat(40),
+	}
+
+	cc := map[string]struct {
+		Recognizer    CandlestickRecognizer
+		TrendDetector TrendDetector
+		Pattern       CandlestickPattern
+		Candles       []Candle
+		Result        bool
+		Error         error
+	}{
+		"Invalid recognizer": {
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHammer,
+			Error:         ErrInvalidIndicator,
+		},
+		"Invalid trend detector": {
+			Recognizer: cr,
+			Pattern:    CandlestickPatternHammer,
+			Error:      ErrInvalidIndicator,
+		},
+		"Invalid pattern": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPattern("invalid"),
+			Error:         ErrInvalidCandlestickPattern,
+		},
+		"Invalid data size": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHammer,
+			Candles:       []Candle{hammer},
+			Error:         ErrInvalidDataSize,
+		},
+		"Pattern not matched": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHammer,
+			Candles:       append(trendCandles(150, 130, 110), doji),
+		},
+		"Hammer after downtrend": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHammer,
+			Candles:       append(trendCandles(150, 130, 110), hammer),
+			Result:        true,
+		},
+		"Hammer after uptrend": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHammer,
+			Candles:       append(trendCandles(50, 60, 70), hammer),
+		},
+		"Hanging man after uptrend": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHangingMan,
+			Candles:       append(trendCandles(50, 60, 70), hangingMan),
+			Result:        true,
+		},
+		"Bearish hammer shaped candle after downtrend": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHammer,
+			Candles:       append(trendCandles(150, 130, 110), hangingMan),
+			Result:        true,
+		},
+		"Bullish hammer shaped candle after uptrend": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHangingMan,
+			Candles:       append(trendCandles(50, 60, 70), hammer),
+			Result:        true,
+		},
+		"Hanging man after downtrend": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternHangingMan,
+			Candles:       append(trendCandles(150, 130, 110), hammer),
+		},
+		"Doji does not depend on the trend": {
+			Recognizer:    cr,
+			TrendDetector: td,
+			Pattern:       CandlestickPatternLongLeggedDoji,
+			Candles:       append(trendCandles(50, 40, 50), doji),
+			Result:        true,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Recognizer.EvalTrend(c.Pattern, c.TrendDetector, c.Candles)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CandlestickPattern_EvalTrend(t *testing.T) {
+	td := TrendDetector{valid: true, ma: SMA{valid: true, length: 2}}
+
+	cc := append(trendCandles(50, 60, 70), Candle{
+		High:  decimal.NewFromFloat(100),
+		Close: decimal.NewFromFloat(40),
+		Open:  decimal.NewFromFloat(50),
+		Low:   decimal.NewFromFloat(40),
+	})
+
+	assert.True(t, CandlestickPatternShootingStar.EvalTrend(td, cc))
+	assert.False(t, CandlestickPatternShootingStar.EvalTrend(TrendDetector{}, cc))
+}
+
+// trendCandles creates candles with the provided close prices.
+func trendCandles(closes ...int64) []Candle {
+	cc := make([]Candle, len(closes))
+
+	for i := range closes {
+		cc[i] = Candle{Close: decimal.NewFromInt(closes[i])}
+	}
+
+	return cc
+}
+
+func Test_CandlestickRecognizer_Scan(t *testing.T) {
+	cc := []Candle{
+		{
+			Open:  decimal.NewFromInt(20),
+			High:  decimal.NewFromInt(21),
+			Low:   decimal.NewFromInt(14),
+			Close: decimal.NewFromInt(15),
+		},
+		{
+			Open:  decimal.NewFromInt(14),
+			High:  decimal.NewFromInt(23),
+			Low:   decimal.NewFromInt(13),
+			Close: decimal.NewFromInt(22),
+		},
+		{
+			Open:  decimal.NewFromInt(70),
+			High:  decimal.NewFromInt(100),
+			Low:   decimal.NewFromInt(40),
+			Close: decimal.NewFromInt(70),
+		},
+	}
+
+	_, err := CandlestickRecognizer{}.Scan(cc)
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	res, err := CandlestickRecognizer{valid: true, opts: DefaultCandlestickOptions()}.Scan(cc)
+	assert.NoError(t, err)
+	assert.Equal(t, []CandlestickMatch{
+		{
+			Index:     1,
+			Pattern:   CandlestickPatternBullishEngulfing,
+			Sentiment: SentimentBullish,
+		},
+		{
+			Index:     2,
+			Pattern:   CandlestickPatternLongLeggedDoji,
+			Sentiment: SentimentNeutral,
+		},
+	}, res)
+
+	assert.Equal(t, res, ScanCandlestickPatterns(cc))
+}
+
+func Test_CandlestickRecognizer_Scan_Sentiments(t *testing.T) {
+	var cc []Candle
+
+	prices := []int64{20, 25, 40, 50, 70, 85, 95, 100}
+
+	for _, low := range prices {
+		for _, high := range prices {
+			for _, open := range prices {
+				for _, cl := range prices {
+					if low > min(open, cl) || high < max(open, cl) {
+						continue
+					}
+
+					cc = append(cc, Candle{
+						Open:  decimal.NewFromInt(open),
+						High:  decimal.NewFromInt(high),
+						Low:   decimal.NewFromInt(low),
+						Close: decimal.NewFromInt(cl),
+					})
+				}
+			}
+		}
+	}
+
+	res, err := CandlestickRecognizer{valid: true, opts: DefaultCandlestickOptions()}.Scan(cc)
+	assert.NoError(t, err)
+	assert.NotEmpty(t, res)
+
+	sentiments := make(map[int]Sentiment)
+
+	for _, m := range res {
+		if m.Sentiment == SentimentNeutral {
+			continue
+		}
+
+		if s, ok := sentiments[m.Index]; ok {
+			assert.Equal(t, s, m.Sentiment, "candle %d, pattern %s", m.Index, m.Pattern)
+		}
+
+		sentiments[m.Index] = m.Sentiment
+	}
+}
+
+func Test_CandlestickRecognizer_ScanTrend(t *testing.T) {
+	cr := CandlestickRecognizer{valid: true, opts: DefaultCandlestickOptions()}
+	td := TrendDetector{valid: true, ma: SMA{valid: true, length: 2}}
+
+	hammer := Candle{
+		High:  decimal.NewFromFloat(100),
+		Close: decimal.NewFromFloat(100),
+		Open:  decimal.NewFromFloat(90),
+		Low:   decimal.NewFromFloat(40),
+	}
+
+	_, err := CandlestickRecognizer{}.ScanTrend(td, nil)
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	_, err = cr.ScanTrend(TrendDetector{}, nil)
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	cc := append(trendCandles(150, 130, 110), hammer)
+	cc = append(cc, trendCandles(120, 140, 160)...)
+	cc = append(cc, hammer)
+
+	res, err := cr.ScanTrend(td, cc)
+	assert.NoError(t, err)
+	assert.Equal(t, []CandlestickMatch{
+		{
+			Index:     3,
+			Pattern:   CandlestickPatternHammer,
+			Sentiment: SentimentBullish,
+		},
+		{
+			Index:     7,
+			Pattern:   CandlestickPatternHangingMan,
+			Sentiment: SentimentBearish,
+		},
+	}, res)
+}
+
+func Test_CandlestickPattern_Sentiment(t *testing.T) {
+	assert.Equal(t, Sentiment(0), CandlestickPattern("invalid").Sentiment())
+	assert.Equal(t, SentimentBullish, CandlestickPatternMorningStar.Sentiment())
+	assert.Equal(t, SentimentBearish, CandlestickPatternShootingStar.Sentiment())
+	assert.Equal(t, SentimentNeutral, CandlestickPatternDragonflyDoji.Sentiment())
+}
+
+func Test_CandlestickPattern_Name(t *testing.T) {
+	assert.Equal(t, "", CandlestickPattern("invalid").Name())
+	assert.Equal(t, "Hammer", CandlestickPatternHammer.Name())
+	assert.Equal(t, "Three White Soldiers", CandlestickPatternThreeWhiteSoldiers.Name())
+	assert.Equal(t, "Long-Legged Doji", CandlestickPatternLongLeggedDoji.Name())
+}
+
+func Test_CandlestickPattern_Reliability(t *testing.T) {
+	assert.Equal(t, Reliability(0), CandlestickPattern("invalid").Reliability())
+	assert.Equal(t, ReliabilityLow, CandlestickPatternHammer.Reliability())
+	assert.Equal(t, ReliabilityMedium, CandlestickPatternPiercingLine.Reliability())
+	assert.Equal(t, ReliabilityHigh, CandlestickPatternEveningStar.Reliability())
+}
+
+func Test_CandlestickPatterns(t *testing.T) {
+	pp := CandlestickPatterns()
+	assert.Len(t, pp, 19)
+
+	for _, cp := range pp {
+		assert.NoError(t, cp.Validate())
+		assert.NotEmpty(t, cp.Name())
+		assert.NoError(t, cp.Sentiment().Validate())
+		assert.NoError(t, cp.Reliability().Validate())
+		assert.NotZero(t, cp.Count())
+	}
+}
@@ -0,0 +1,442 @@
+// Package fast provides float64 implementations of tango indicators for
+// cases where the speed of the calculations is more important than the
+// precision of decimal numbers, e.g. parameter sweeps over millions of
+// data points.
+//
+// Constructors, validation and returned errors are identical to the ones
+// of tango, and the same configuration types (e.g. tango.MAType or
+// tango.Band) are used. The values match the ones calculated by tango
+// within the precision of float64.
+//
+// Not everything tango provides is available: candlestick pattern
+// recognition (tango.CandlestickRecognizer and tango.TrendDetector), the
+// VWAP stream and the candle price helpers other than TypicalPrice, Range
+// and TrueRange are left out. Moving average types registered with
+// tango.RegisterMA are supported, however they are calculated in decimal
+// and converted to float64, so they are as slow as in tango.
+package fast
+
+import (
+	"math"
+	"time"
+
+	"github.com/jellydator/tango"
+	"github.com/shopspring/decimal"
+)
+
+// MA is an interface that all moving averages implement.
+type MA interface {
+	// Calc should return calculation results based on provided data
+	// points slice.
+	Calc([]float64) (float64, error)
+
+	// Count should determine the total amount data points required for
+	// the calculation.
+	Count() int
+}
+
+// NewMA constructs new moving average based on the provided type. Moving
+// average types registered with tango.RegisterMA are constructed by tango
+// and wrapped, so that they accept and return float64 values.
+func NewMA(mat tango.MAType, length int) (MA, error) {
+	switch mat {
+	case tango.MATypeDoubleExponential:
+		return NewDEMA(length)
+	case tango.MATypeExponential:
+		return NewEMA(length)
+	case tango.MATypeHull:
+		return NewHMA(length)
+	case tango.MATypeSimple:
+		return NewSMA(length)
+	case tango.MATypeWeighted:
+		return NewWMA(length)
+	case tango.MATypeSmoothed:
+		return NewSMMA(length)
+	case tango.MATypeTripleExponential:
+		return NewTEMA(length)
+	case tango.MATypeKaufmanAdaptive:
+		return NewKAMA(length)
+	case tango.MATypeArnaudLegoux:
+		return NewALMA(length)
+	case tango.MATypeZeroLagExponential:
+		return NewZLEMA(length)
+	case tango.MATypeTillson:
+		return NewT3(length)
+	default:
+		ma, err := tango.NewMA(mat, length)
+		if err != nil {
+			return nil, err
+		}
+
+		return decimalMA{ma: ma}, nil
+	}
+}
+
+// decimalMA wraps a moving average registered with tango.RegisterMA, so
+// that it can be used with float64 values.
+type decimalMA struct {
+	// ma specifies the wrapped moving average.
+	ma tango.MA
+}
+
+// Calc calculates the wrapped moving average from the provided data
+// points slice.
+func (ma decimalMA) Calc(dd []float64) (float64, error) {
+	res, err := ma.ma.Calc(toDecimals(dd))
+	if err != nil {
+		return 0, err
+	}
+
+	return res.InexactFloat64(), nil
+}
+
+// Count determines the total amount of data points needed for the
+// wrapped moving average calculation.
+func (ma decimalMA) Count() int {
+	return ma.ma.Count()
+}
+
+// CalcSeries calculates the wrapped moving average for every window of
+// Count() data points of the provided slice by using tango.CalcMASeries.
+func (ma decimalMA) CalcSeries(dd []float64) ([]float64, error) {
+	res, err := tango.CalcMASeries(ma.ma, toDecimals(dd))
+	if err != nil {
+		return nil, err
+	}
+
+	ff := make([]float64, len(res))
+
+	for i := range res {
+		ff[i] = res[i].InexactFloat64()
+	}
+
+	return ff, nil
+}
+
+// toDecimals converts float64 data points to decimal ones.
+func toDecimals(dd []float64) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(dd))
+
+	for i := range dd {
+		res[i] = decimal.NewFromFloat(dd[i])
+	}
+
+	return res
+}
+
+// CalcMASeries calculates moving average value for every window of
+// ma.Count() data points of the provided slice. Moving averages that
+// implement CalcSeries method use it, other ones are calculated window by
+// window. Either way, the values match the ones ma.Calc produces.
+func CalcMASeries(ma MA, dd []float64) ([]float64, error) {
+	if s, ok := ma.(interface {
+		CalcSeries([]float64) ([]float64, error)
+	}); ok {
+		return s.CalcSeries(dd)
+	}
+
+	return calcWindows(ma.Count(), dd, ma.Calc)
+}
+
+// Candle holds the opening time, the prices and the volume of a single
+// candle.
+type Candle struct {
+	// Open is the opening price of the candle.
+	Open float64
+
+	// High is the highest price of the candle.
+	High float64
+
+	// Low is the lowest price of the candle.
+	Low float64
+
+	// Close is the closing price of the candle.
+	Close float64
+
+	// Volume is the traded volume of the candle.
+	Volume float64
+
+	// Time is the opening time of the candle. It is used only by the
+	// session and anchor based calculations of AnchoredVWAP.
+	Time time.Time
+}
+
+// FromCandles converts tango candles to float64 candles.
+func FromCandles(cc []tango.Candle) []Candle {
+	res := make([]Candle, len(cc))
+
+	for i := range cc {
+		res[i] = Candle{
+			Open:   cc[i].Open.InexactFloat64(),
+			High:   cc[i].High.InexactFloat64(),
+			Low:    cc[i].Low.InexactFloat64(),
+			Close:  cc[i].Close.InexactFloat64(),
+			Volume: cc[i].Volume.InexactFloat64(),
+			Time:   cc[i].Time,
+		}
+	}
+
+	return res
+}
+
+// TypicalPrice calculates the typical price of the candle: (H+L+C)/3.
+func (c Candle) TypicalPrice() float64 {
+	return (c.High + c.Low + c.Close) / 3
+}
+
+// typicalPrices calculates the typical price of every candle.
+func typicalPrices(cc []Candle) []float64 {
+	res := make([]float64, len(cc))
+
+	for i := range cc {
+		res[i] = cc[i].TypicalPrice()
+	}
+
+	return res
+}
+
+// Range calculates the difference between the highest and the lowest
+// prices of the candle.
+func (c Candle) Range() float64 {
+	return c.High - c.Low
+}
+
+// TrueRange calculates the true range of the candle, which, in addition
+// to the candle range, includes the gap from the previous candle's close.
+func (c Candle) TrueRange(prev Candle) float64 {
+	return math.Max(
+		c.Range(),
+		math.Max(math.Abs(c.High-prev.Close), math.Abs(c.Low-prev.Close)),
+	)
+}
+
+// Average is a helper function that calculates average number of
+// given slice.
+func Average(dd []float64) float64 {
+	var sum float64
+
+	for i := range dd {
+		sum += dd[i]
+	}
+
+	return sum / float64(len(dd))
+}
+
+// MeanDeviation calculates mean deviation of given slice.
+func MeanDeviation(dd []float64) float64 {
+	if len(dd) == 0 {
+		return 0
+	}
+
+	var res float64
+
+	mean := Average(dd)
+
+	for i := range dd {
+		res += math.Abs(dd[i] - mean)
+	}
+
+	return res / float64(len(dd))
+}
+
+// StandardDeviation calculates standard deviation of given slice.
+func StandardDeviation(dd []float64) float64 {
+	if len(dd) == 0 {
+		return 0
+	}
+
+	return math.Sqrt(squaredDeviations(dd) / float64(len(dd)))
+}
+
+// SampleStandardDeviation calculates sample standard deviation of given
+// slice, i.e. the sum of squared deviations is divided by n-1 instead of
+// n (Bessel's correction).
+func SampleStandardDeviation(dd []float64) float64 {
+	if len(dd) < 2 {
+		return 0
+	}
+
+	return math.Sqrt(squaredDeviations(dd) / float64(len(dd)-1))
+}
+
+// squaredDeviations calculates the sum of squared deviations from the
+// mean of given slice.
+func squaredDeviations(dd []float64) float64 {
+	var res float64
+
+	mean := Average(dd)
+
+	for i := range dd {
+		res += (dd[i] - mean) * (dd[i] - mean)
+	}
+
+	return res
+}
+
+// calcWindows calculates a value for every window of count data points
+// by passing each window to the provided calc function.
+func calcWindows[I, O any](count int, dd []I, calc func([]I) (O, error)) ([]O, error) {
+	if count < 1 || len(dd) < count {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res := make([]O, len(dd)-count+1)
+
+	for i := range res {
+		var err error
+
+		res[i], err = calc(dd[i : i+count])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// rollingExtremes calculates the lowest and the highest values of every
+// window of the given length. Monotonic deques are used so that the whole
+// calculation takes linear time.
+func rollingExtremes(dd []float64, length int) (lows, highs []float64) {
+	lidx, hidx := rollingExtremeIndexes(dd, length)
+	if lidx == nil {
+		return nil, nil
+	}
+
+	lows = make([]float64, len(lidx))
+	highs = make([]float64, len(hidx))
+
+	for i := range lidx {
+		lows[i] = dd[lidx[i]]
+		highs[i] = dd[hidx[i]]
+	}
+
+	return lows, highs
+}
+
+// rollingExtremeIndexes calculates the indexes of the lowest and the
+// highest values of every window of the given length. When the extreme
+// value occurs more than once, the index of the most recent occurrence
+// is used. Monotonic deques are used so that the whole calculation takes
+// linear time.
+func rollingExtremeIndexes(dd []float64, length int) (lows, highs []int) {
+	if length < 1 || len(dd) < length {
+		return nil, nil
+	}
+
+	lows = make([]int, len(dd)-length+1)
+	highs = make([]int, len(dd)-length+1)
+
+	// minq and maxq hold indexes of the values that may still become
+	// the lowest or the highest value of a window.
+	minq := make([]int, 0, length)
+	maxq := make([]int, 0, length)
+
+	for i := range dd {
+		for len(minq) > 0 && dd[minq[len(minq)-1]] >= dd[i] {
+			minq = minq[:len(minq)-1]
+		}
+
+		for len(maxq) > 0 && dd[maxq[len(maxq)-1]] <= dd[i] {
+			maxq = maxq[:len(maxq)-1]
+		}
+
+		minq = append(minq, i)
+		maxq = append(maxq, i)
+
+		if minq[0] <= i-length {
+			minq = minq[1:]
+		}
+
+		if maxq[0] <= i-length {
+			maxq = maxq[1:]
+		}
+
+		if i >= length-1 {
+			lows[i-length+1] = minq[0]
+			highs[i-length+1] = maxq[0]
+		}
+	}
+
+	return lows, highs
+}
+
+// rollingReversalIndexes calculates, for every window of the given length,
+// the indexes of the lowest and the highest values found by walking the
+// window backwards from its most recent value and stopping at the first
+// reversal, as Aroon does. Equal values do not stop the walk and the most
+// recent one of them is used. The whole calculation takes linear time.
+func rollingReversalIndexes(dd []float64, length int) (lows, highs []int) {
+	if length < 1 || len(dd) < length {
+		return nil, nil
+	}
+
+	// lowStarts and highStarts hold the start indexes of the
+	// non-decreasing and the non-increasing runs ending at each index,
+	// while flatEnds holds the end indexes of the runs of equal values
+	// starting at each index.
+	lowStarts := make([]int, len(dd))
+	highStarts := make([]int, len(dd))
+	flatEnds := make([]int, len(dd))
+
+	for i := 1; i < len(dd); i++ {
+		lowStarts[i], highStarts[i] = i, i
+
+		if dd[i-1] <= dd[i] {
+			lowStarts[i] = lowStarts[i-1]
+		}
+
+		if dd[i-1] >= dd[i] {
+			highStarts[i] = highStarts[i-1]
+		}
+	}
+
+	flatEnds[len(dd)-1] = len(dd) - 1
+
+	for i := len(dd) - 2; i >= 0; i-- {
+		flatEnds[i] = i
+
+		if dd[i] == dd[i+1] {
+			flatEnds[i] = flatEnds[i+1]
+		}
+	}
+
+	lows = make([]int, len(dd)-length+1)
+	highs = make([]int, len(dd)-length+1)
+
+	for i := range lows {
+		end := i + length - 1
+		lows[i] = min(flatEnds[max(lowStarts[end], i)], end)
+		highs[i] = min(flatEnds[max(highStarts[end], i)], end)
+	}
+
+	return lows, highs
+}
+
+// rollingSums calculates the sum of every window of the given length.
+// The sum is updated by adding and subtracting values, so it may drift
+// from the one calculated separately for every window within the
+// precision of float64.
+func rollingSums(dd []float64, length int) []float64 {
+	if length < 1 || len(dd) < length {
+		return nil
+	}
+
+	res := make([]float64, len(dd)-length+1)
+
+	var sum float64
+
+	for i := range dd {
+		sum += dd[i]
+
+		if i >= length {
+			sum -= dd[i-length]
+		}
+
+		if i >= length-1 {
+			res[i-length+1] = sum
+		}
+	}
+
+	return res
+}
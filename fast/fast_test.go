@@ -0,0 +1,442 @@
+package fast
+
+import (
+	"math"
+	"sync"
+	"testing"
+	"time"
+
+	"github.com/jellydator/tango"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// _tolerance specifies the maximum allowed difference between float64
+// and decimal calculation results.
+const _tolerance = 1e-8
+
+func assertEqualError(t *testing.T, exp, err error) {
+	t.Helper()
+
+	if exp != nil {
+		if exp == assert.AnError { //nolint:goerr113 // direct check is needed
+			assert.Error(t, err)
+			return
+		}
+
+		assert.Equal(t, exp, err)
+
+		return
+	}
+
+	assert.NoError(t, err)
+}
+
+// assertCloseTo checks whether the float64 result matches the decimal one
+// within the tolerance.
+func assertCloseTo(t *testing.T, exp decimal.Decimal, res float64) {
+	t.Helper()
+
+	assert.InDelta(t, exp.InexactFloat64(), res, _tolerance)
+}
+
+// assertSeriesCloseTo checks whether every float64 result matches the
+// corresponding decimal one within the tolerance.
+func assertSeriesCloseTo(t *testing.T, exp []decimal.Decimal, res []float64) {
+	t.Helper()
+
+	if !assert.Len(t, res, len(exp)) {
+		return
+	}
+
+	for i := range exp {
+		assertCloseTo(t, exp[i], res[i])
+	}
+}
+
+// assertStreamMatchesCalc checks whether the stream produces the same
+// values as calc does over every window of the provided data points.
+func assertStreamMatchesCalc[I, O any](
+	t *testing.T,
+	s *tango.Stream[I, O],
+	count int,
+	calc func([]I) (O, error),
+	dd []I,
+) {
+
+	t.Helper()
+
+	for i := range dd {
+		res, ok, err := s.Update(dd[i])
+		assert.NoError(t, err)
+
+		if i+1 < count {
+			assert.False(t, ok)
+			continue
+		}
+
+		exp, err := calc(dd[i+1-count : i+1])
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, exp, res)
+	}
+}
+
+// crossCheckData returns data points used to verify that float64
+// calculations match decimal ones.
+func crossCheckData() []float64 {
+	res := make([]float64, 60)
+
+	for i := range res {
+		d := 64 + 3*math.Sin(float64(i)/4) + float64(i%7)*0.35 - float64(i%3)*0.5
+		res[i] = math.Round(d*100) / 100
+	}
+
+	return res
+}
+
+// crossCheckCandles returns candles used to verify that float64
+// calculations match decimal ones. Closing prices match crossCheckData,
+// each candle opens at the previous close.
+func crossCheckCandles() ([]Candle, []tango.Candle) {
+	dd := decimals(crossCheckData())
+	start := time.Date(2023, 1, 2, 9, 30, 0, 0, time.UTC)
+	res := make([]tango.Candle, len(dd))
+
+	for i := range dd {
+		open := dd[i]
+		if i > 0 {
+			open = dd[i-1]
+		}
+
+		res[i] = tango.Candle{
+			Time:     start.Add(time.Duration(i) * time.Minute),
+			Interval: time.Minute,
+			Open:     open,
+			High:     decimal.Max(open, dd[i]).Add(decimal.RequireFromString("0.25")),
+			Low:      decimal.Min(open, dd[i]).Sub(decimal.RequireFromString("0.35")),
+			Close:    dd[i],
+			Volume:   decimal.NewFromInt(int64(1000 + i%5*100)),
+		}
+	}
+
+	return FromCandles(res), res
+}
+
+// decimals converts float64 data points to decimal ones.
+func decimals(ff []float64) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(ff))
+
+	for i := range ff {
+		res[i] = decimal.NewFromFloat(ff[i])
+	}
+
+	return res
+}
+
+// _customMA holds the moving average type registered by customMAType.
+var _customMA struct {
+	once sync.Once
+	mat  tango.MAType
+	err  error
+}
+
+// customMAType registers SMA as a custom moving average type. Registered
+// types can be removed only by tango's own tests, so the type is
+// registered once per process, which keeps repeated runs (e.g. -count=2)
+// from failing on a duplicate name.
+func customMAType(t *testing.T) tango.MAType {
+	t.Helper()
+
+	_customMA.once.Do(func() {
+		_customMA.mat, _customMA.err = tango.RegisterMA("fast-custom", func(length int) (tango.MA, error) {
+			return tango.NewSMA(length)
+		})
+	})
+
+	assert.NoError(t, _customMA.err)
+
+	return _customMA.mat
+}
+
+func Test_NewMA(t *testing.T) {
+	custom := customMAType(t)
+
+	tsma, err := tango.NewSMA(1)
+	assert.NoError(t, err)
+
+	cc := map[string]struct {
+		MAType tango.MAType
+		Length int
+		Result MA
+		Error  error
+	}{
+		"Invalid MA type": {
+			MAType: 70,
+			Length: 1,
+			Error:  tango.ErrInvalidMA,
+		},
+		"Invalid length of registered MA type": {
+			MAType: custom,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Successfully created new registered MA type": {
+			MAType: custom,
+			Length: 1,
+			Result: decimalMA{ma: tsma},
+		},
+		"Invalid length": {
+			MAType: tango.MATypeSimple,
+			Result: SMA{},
+			Error:  tango.ErrInvalidLength,
+		},
+		"Successfully created new DEMA": {
+			MAType: tango.MATypeDoubleExponential,
+			Length: 1,
+			Result: DEMA{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 1}}},
+		},
+		"Successfully created new EMA": {
+			MAType: tango.MATypeExponential,
+			Length: 1,
+			Result: EMA{valid: true, sma: SMA{valid: true, length: 1}},
+		},
+		"Successfully created new HMA": {
+			MAType: tango.MATypeHull,
+			Length: 1,
+			Result: HMA{valid: true, wma: WMA{valid: true, length: 1}},
+		},
+		"Successfully created new SMA": {
+			MAType: tango.MATypeSimple,
+			Length: 1,
+			Result: SMA{valid: true, length: 1},
+		},
+		"Successfully created new WMA": {
+			MAType: tango.MATypeWeighted,
+			Length: 1,
+			Result: WMA{valid: true, length: 1},
+		},
+		"Successfully created new SMMA": {
+			MAType: tango.MATypeSmoothed,
+			Length: 1,
+			Result: SMMA{valid: true, sma: SMA{valid: true, length: 1}},
+		},
+		"Successfully created new TEMA": {
+			MAType: tango.MATypeTripleExponential,
+			Length: 1,
+			Result: TEMA{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 1}}},
+		},
+		"Successfully created new KAMA": {
+			MAType: tango.MATypeKaufmanAdaptive,
+			Length: 1,
+			Result: KAMA{valid: true, sma: SMA{valid: true, length: 1}, fast: 2, slow: 30},
+		},
+		"Successfully created new ALMA": {
+			MAType: tango.MATypeArnaudLegoux,
+			Length: 1,
+			Result: ALMA{valid: true, length: 1, offset: 0.85, sigma: 6},
+		},
+		"Successfully created new ZLEMA": {
+			MAType: tango.MATypeZeroLagExponential,
+			Length: 1,
+			Result: ZLEMA{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 1}}},
+		},
+		"Successfully created new T3": {
+			MAType: tango.MATypeTillson,
+			Length: 1,
+			Result: T3{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 1}}, factor: 0.7},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMA(c.MAType, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_decimalMA_CrossCheck(t *testing.T) {
+	ma, err := NewMA(customMAType(t), 5)
+	assert.NoError(t, err)
+
+	sma, err := NewSMA(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, sma.Count(), ma.Count())
+
+	dd := crossCheckData()
+
+	_, err = ma.Calc(dd[:ma.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = CalcMASeries(ma, dd[:ma.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	for i := ma.Count(); i <= len(dd); i++ {
+		exp, err := sma.Calc(dd[i-ma.Count() : i])
+		assert.NoError(t, err)
+
+		res, err := ma.Calc(dd[i-ma.Count() : i])
+		assert.NoError(t, err)
+		assert.InDelta(t, exp, res, _tolerance)
+	}
+
+	exp, err := sma.CalcSeries(dd)
+	assert.NoError(t, err)
+
+	res, err := CalcMASeries(ma, dd)
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, exp, res, _tolerance)
+}
+
+// windowMA is a moving average that doesn't implement CalcSeries.
+type windowMA struct {
+	SMA
+}
+
+func (ma windowMA) CalcSeries() {}
+
+func Test_CalcMASeries(t *testing.T) {
+	sma, err := NewSMA(3)
+	assert.NoError(t, err)
+
+	_, err = CalcMASeries(windowMA{SMA: sma}, crossCheckData()[:2])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	exp, err := sma.CalcSeries(crossCheckData())
+	assert.NoError(t, err)
+
+	res, err := CalcMASeries(windowMA{SMA: sma}, crossCheckData())
+	assert.NoError(t, err)
+	assert.InDeltaSlice(t, exp, res, _tolerance)
+
+	res, err = CalcMASeries(sma, crossCheckData())
+	assert.NoError(t, err)
+	assert.Equal(t, exp, res)
+}
+
+func Test_FromCandles(t *testing.T) {
+	start := time.Date(2023, 1, 2, 9, 30, 0, 0, time.UTC)
+
+	assert.Equal(t, []Candle{
+		{Time: start, Open: 1, High: 2.5, Low: 0.5, Close: 1.5, Volume: 100},
+	}, FromCandles([]tango.Candle{
+		{
+			Time:     start,
+			Interval: time.Minute,
+			Open:     decimal.NewFromInt(1),
+			High:     decimal.RequireFromString("2.5"),
+			Low:      decimal.RequireFromString("0.5"),
+			Close:    decimal.RequireFromString("1.5"),
+			Volume:   decimal.NewFromInt(100),
+		},
+	}))
+}
+
+func Test_Candle_TypicalPrice(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	for i := range cc {
+		assertCloseTo(t, tcc[i].TypicalPrice(), cc[i].TypicalPrice())
+	}
+}
+
+func Test_Candle_Range(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	for i := range cc {
+		assertCloseTo(t, tcc[i].Range(), cc[i].Range())
+	}
+}
+
+func Test_Candle_TrueRange(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	for i := 1; i < len(cc); i++ {
+		assertCloseTo(t, tcc[i].TrueRange(tcc[i-1]), cc[i].TrueRange(cc[i-1]))
+	}
+
+	assert.Equal(t, 3.0, Candle{High: 2, Low: 1}.TrueRange(Candle{Close: 4}))
+	assert.Equal(t, 2.0, Candle{High: 2, Low: 1}.TrueRange(Candle{Close: 0}))
+}
+
+func Test_Average(t *testing.T) {
+	assertCloseTo(t, tango.Average(decimals(crossCheckData())), Average(crossCheckData()))
+}
+
+func Test_MeanDeviation(t *testing.T) {
+	assert.Equal(t, 0.0, MeanDeviation(nil))
+	assertCloseTo(t, tango.MeanDeviation(decimals(crossCheckData())), MeanDeviation(crossCheckData()))
+}
+
+func Test_StandardDeviation(t *testing.T) {
+	assert.Equal(t, 0.0, StandardDeviation(nil))
+	assertCloseTo(t, tango.StandardDeviation(decimals(crossCheckData())), StandardDeviation(crossCheckData()))
+}
+
+func Test_SampleStandardDeviation(t *testing.T) {
+	assert.Equal(t, 0.0, SampleStandardDeviation([]float64{1}))
+	assertCloseTo(t, tango.SampleStandardDeviation(decimals(crossCheckData())), SampleStandardDeviation(crossCheckData()))
+}
+
+func Test_rollingExtremes(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Data   []float64
+		Lows   []float64
+		Highs  []float64
+	}{
+		"Invalid length": {
+			Data: []float64{1},
+		},
+		"Invalid data size": {
+			Length: 2,
+			Data:   []float64{1},
+		},
+		"Successful calculation": {
+			Length: 3,
+			Data:   []float64{5, 1, 3, 3, 7, 2, 6},
+			Lows:   []float64{1, 1, 3, 2, 2},
+			Highs:  []float64{5, 3, 7, 7, 7},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			lows, highs := rollingExtremes(c.Data, c.Length)
+			assert.Equal(t, c.Lows, lows)
+			assert.Equal(t, c.Highs, highs)
+		})
+	}
+}
+
+func Test_rollingExtremeIndexes(t *testing.T) {
+	lows, highs := rollingExtremeIndexes([]float64{5, 1, 3, 3, 7, 7, 2}, 3)
+	assert.Equal(t, []int{1, 1, 3, 3, 6}, lows)
+	assert.Equal(t, []int{0, 3, 4, 5, 5}, highs)
+}
+
+func Test_rollingReversalIndexes(t *testing.T) {
+	lows, highs := rollingReversalIndexes(nil, 0)
+	assert.Nil(t, lows)
+	assert.Nil(t, highs)
+
+	lows, highs = rollingReversalIndexes([]float64{5, 1, 3, 3, 7, 7, 2}, 3)
+	assert.Equal(t, []int{1, 1, 3, 3, 6}, lows)
+	assert.Equal(t, []int{2, 3, 4, 5, 5}, highs)
+}
+
+func Test_rollingSums(t *testing.T) {
+	assert.Nil(t, rollingSums(nil, 0))
+	assert.Nil(t, rollingSums([]float64{1}, 2))
+	assert.Equal(t, []float64{9.5, 2.5, 8.5}, rollingSums([]float64{5, 1, 3.5, -2, 7}, 3))
+}
@@ -0,0 +1,1791 @@
+package fast
+
+import (
+	"math"
+
+	"github.com/jellydator/tango"
+)
+
+// ADXResult holds all values produced by a single ADX calculation.
+type ADXResult struct {
+	// ADX is the average directional index value.
+	ADX float64
+
+	// PlusDI is the positive directional indicator (+DI) value.
+	PlusDI float64
+
+	// MinusDI is the negative directional indicator (-DI) value.
+	MinusDI float64
+}
+
+// ADX holds all the necessary information needed to calculate average
+// directional index together with the positive and negative directional
+// indicators.
+// The zero value is not usable.
+type ADX struct {
+	// valid specifies whether ADX paremeters were validated.
+	valid bool
+
+	// smma specifies Wilder's moving average configuration used to smooth
+	// directional movements, true ranges and directional index values.
+	smma SMMA
+}
+
+// NewADX validates provided configuration options and
+// creates new ADX indicator instance.
+func NewADX(length int) (ADX, error) {
+	smma, err := NewSMMA(length)
+	if err != nil {
+		return ADX{}, err
+	}
+
+	return ADX{
+		valid: true,
+		smma:  smma,
+	}, nil
+}
+
+// Calc calculates ADX, +DI and -DI from the provided candles slice.
+// Directional movements and true ranges are smoothed with Wilder's moving
+// average before the directional index values are smoothed the same way.
+func (adx ADX) Calc(cc []Candle) (adxv, plusDI, minusDI float64, err error) {
+	if !adx.valid {
+		return 0, 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != adx.Count() {
+		return 0, 0, 0, tango.ErrInvalidDataSize
+	}
+
+	res, err := adx.calc(cc)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, 0, err
+	}
+
+	return res[0].ADX, res[0].PlusDI, res[0].MinusDI, nil
+}
+
+// CalcTrend calculates specified directional indicator from the provided
+// candles slice. tango.TrendUp returns +DI, while tango.TrendDown returns
+// -DI.
+func (adx ADX) CalcTrend(cc []Candle, trend tango.Trend) (float64, error) {
+	if err := trend.Validate(); err != nil {
+		return 0, err
+	}
+
+	_, plusDI, minusDI, err := adx.Calc(cc)
+	if err != nil {
+		return 0, err
+	}
+
+	if trend == tango.TrendDown {
+		return minusDI, nil
+	}
+
+	return plusDI, nil
+}
+
+// calc calculates ADX, +DI and -DI from the provided candles slice. The
+// smoothed values are carried over from one candle to the next, so the
+// first result covers the first Count() candles, while every following one
+// adds a single candle.
+func (adx ADX) calc(cc []Candle) ([]ADXResult, error) {
+	plusDM, minusDM := directionalMovements(cc)
+
+	plus, err := adx.smma.CalcContinuousSeries(plusDM)
+	if err != nil {
+		return nil, err
+	}
+
+	minus, err := adx.smma.CalcContinuousSeries(minusDM)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := adx.smma.CalcContinuousSeries(trueRanges(cc))
+	if err != nil {
+		return nil, err
+	}
+
+	plusDI := make([]float64, len(tr))
+	minusDI := make([]float64, len(tr))
+	dx := make([]float64, len(tr))
+
+	for i := range tr {
+		plusDI[i] = calcDirectionalIndicator(plus[i], tr[i])
+		minusDI[i] = calcDirectionalIndicator(minus[i], tr[i])
+
+		if sum := plusDI[i] + minusDI[i]; sum != 0 {
+			dx[i] = math.Abs(plusDI[i]-minusDI[i]) / sum * 100
+		}
+	}
+
+	aa, err := adx.smma.CalcContinuousSeries(dx)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := len(dx) - len(aa)
+	res := make([]ADXResult, len(aa))
+
+	for i := range aa {
+		res[i] = ADXResult{
+			ADX:     aa[i],
+			PlusDI:  plusDI[i+offset],
+			MinusDI: minusDI[i+offset],
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for ADX
+// calculation.
+func (adx ADX) Count() int {
+	return adx.smma.Count() * 2
+}
+
+// CalcSeries calculates ADX, +DI and -DI for every window of Count()
+// candles of the provided slice. Wilder's smoothing is restarted for every
+// window, so the values are identical to the ones Calc produces.
+func (adx ADX) CalcSeries(cc []Candle) ([]ADXResult, error) {
+	if !adx.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(adx.Count(), cc, func(cc []Candle) (ADXResult, error) {
+		adxv, plusDI, minusDI, err := adx.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return ADXResult{}, err
+		}
+
+		return ADXResult{
+			ADX:     adxv,
+			PlusDI:  plusDI,
+			MinusDI: minusDI,
+		}, nil
+	})
+}
+
+// Stream creates new ADX stream that calculates ADX, +DI and -DI from
+// the most recent candles each time a new candle is added.
+func (adx ADX) Stream() (*tango.Stream[Candle, ADXResult], error) {
+	if !adx.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(adx.Count(), func(cc []Candle) (ADXResult, error) {
+		adxv, plusDI, minusDI, err := adx.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return ADXResult{}, err
+		}
+
+		return ADXResult{
+			ADX:     adxv,
+			PlusDI:  plusDI,
+			MinusDI: minusDI,
+		}, nil
+	})
+}
+
+// directionalMovements calculates positive and negative directional
+// movements of every candle, except the first one, by comparing its high
+// and low prices with the ones of the candle preceding it.
+func directionalMovements(cc []Candle) (plus, minus []float64) {
+	if len(cc) < 2 {
+		return nil, nil
+	}
+
+	plus = make([]float64, len(cc)-1)
+	minus = make([]float64, len(cc)-1)
+
+	for i := range plus {
+		up := cc[i+1].High - cc[i].High
+		down := cc[i].Low - cc[i+1].Low
+
+		if up > down && up > 0 {
+			plus[i] = up
+		}
+
+		if down > up && down > 0 {
+			minus[i] = down
+		}
+	}
+
+	return plus, minus
+}
+
+// calcDirectionalIndicator calculates directional indicator from the
+// smoothed directional movement and true range.
+func calcDirectionalIndicator(dm, tr float64) float64 {
+	if tr == 0 {
+		return 0
+	}
+
+	return dm / tr * 100
+}
+
+// AroonResult holds both trend values produced by a single Aroon
+// calculation.
+type AroonResult struct {
+	// Uptrend is the Aroon up value.
+	Uptrend float64
+
+	// Downtrend is the Aroon down value.
+	Downtrend float64
+}
+
+// Aroon holds all the necessary information needed to calculate Aroon.
+// The zero value is not usable.
+type Aroon struct {
+	// valid specifies whether Aroon paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewAroon validates provided configuration options and
+// creates new Aroon indicator instance.
+func NewAroon(length int) (Aroon, error) {
+	aroon := Aroon{
+		length: length,
+	}
+
+	if err := aroon.validate(); err != nil {
+		return Aroon{}, err
+	}
+
+	return aroon, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (aroon *Aroon) validate() error {
+	if aroon.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	aroon.valid = true
+
+	return nil
+}
+
+// Calc calculates both Aroon trends from the provided data points slice.
+// When the highest or the lowest data point occurs more than once, the most
+// recent occurrence is used.
+func (aroon Aroon) Calc(dd []float64) (uptrend, downtrend float64, err error) {
+	if !aroon.valid {
+		return 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != aroon.Count() {
+		return 0, 0, tango.ErrInvalidDataSize
+	}
+
+	minValue := dd[len(dd)-1]
+	minIndex := len(dd) - 1
+	foundMin := false
+
+	maxValue := dd[len(dd)-1]
+	maxIndex := len(dd) - 1
+	foundMax := false
+
+	for i := len(dd) - 2; i >= 0 && (!foundMin || !foundMax); i-- {
+		if !foundMin && minValue > dd[i] {
+			minValue = dd[i]
+			minIndex = i
+		} else if minValue != dd[i] {
+			foundMin = true
+		}
+
+		if !foundMax && maxValue < dd[i] {
+			maxValue = dd[i]
+			maxIndex = i
+		} else if maxValue != dd[i] {
+			foundMax = true
+		}
+	}
+
+	return aroon.calc(maxIndex), aroon.calc(minIndex), nil
+}
+
+// CalcTrend calculates specified Aroon trend from the provided data points
+// slice.
+func (aroon Aroon) CalcTrend(dd []float64, trend tango.Trend) (float64, error) {
+	if err := trend.Validate(); err != nil {
+		return 0, err
+	}
+
+	uptrend, downtrend, err := aroon.Calc(dd)
+	if err != nil {
+		return 0, err
+	}
+
+	if trend == tango.TrendDown {
+		return downtrend, nil
+	}
+
+	return uptrend, nil
+}
+
+// calc calculates Aroon value from the position of the extreme value
+// within the window, the most recent data point being at the length-th
+// position.
+func (aroon Aroon) calc(index int) float64 {
+	return float64(index) * 100 / float64(aroon.length)
+}
+
+// Count determines the total amount of data points needed for Aroon
+// calculation.
+func (aroon Aroon) Count() int {
+	return aroon.length + 1
+}
+
+// CalcSeries calculates both Aroon trends for every window of Count()
+// data points of the provided slice. The positions of the extreme values
+// are determined for all windows at once, so the calculation takes linear
+// time.
+func (aroon Aroon) CalcSeries(dd []float64) ([]AroonResult, error) {
+	if !aroon.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < aroon.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	lows, highs := rollingReversalIndexes(dd, aroon.Count())
+	res := make([]AroonResult, len(lows))
+
+	for i := range res {
+		res[i] = AroonResult{
+			Uptrend:   aroon.calc(highs[i] - i),
+			Downtrend: aroon.calc(lows[i] - i),
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new Aroon stream that calculates both Aroon trends from
+// the most recent data points each time a new data point is added.
+func (aroon Aroon) Stream() (*tango.Stream[float64, AroonResult], error) {
+	if !aroon.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(aroon.Count(), func(dd []float64) (AroonResult, error) {
+		uptrend, downtrend, err := aroon.Calc(dd)
+		if err != nil {
+			// unlikely to happen
+			return AroonResult{}, err
+		}
+
+		return AroonResult{
+			Uptrend:   uptrend,
+			Downtrend: downtrend,
+		}, nil
+	})
+}
+
+// CCI holds all the necessary information needed to calculate commodity
+// channel index.
+// The zero value is not usable.
+type CCI struct {
+	// valid specifies whether CCI paremeters were validated.
+	valid bool
+
+	// ma specifies moving average indicator configuration.
+	ma MA
+
+	// factor specifies Lambert's constant which scales mean deviation.
+	factor float64
+}
+
+// NewCCI validates provided configuration options and creates
+// new CCI indicator. Default factor (0.015) is used.
+func NewCCI(mat tango.MAType, length int) (CCI, error) {
+	return NewCCIWithFactor(mat, length, 0)
+}
+
+// NewCCIWithFactor validates provided configuration options and creates
+// new CCI indicator with the provided factor.
+// If provided factor is zero, default value is going to be used (0.015).
+func NewCCIWithFactor(mat tango.MAType, length int, factor float64) (CCI, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return CCI{}, err
+	}
+
+	if factor == 0 {
+		factor = 0.015
+	}
+
+	cci := CCI{
+		ma:     ma,
+		factor: factor,
+	}
+
+	if err := cci.validate(); err != nil {
+		return CCI{}, err
+	}
+
+	return cci, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (cci *CCI) validate() error {
+	if cci.factor <= 0 {
+		return tango.ErrInvalidFactor
+	}
+
+	cci.valid = true
+
+	return nil
+}
+
+// Calc calculates CCI from the provided data points slice.
+func (cci CCI) Calc(dd []float64) (float64, error) {
+	if !cci.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != cci.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	res, err := cci.ma.Calc(dd)
+	if err != nil {
+		return 0, err
+	}
+
+	return cci.calc(dd, res), nil
+}
+
+// CalcCandles calculates CCI from the provided candles slice. Typical
+// price of each candle is used, as defined by Donald Lambert.
+func (cci CCI) CalcCandles(cc []Candle) (float64, error) {
+	return cci.Calc(typicalPrices(cc))
+}
+
+// calc calculates CCI from the provided data points slice and its moving
+// average value.
+func (cci CCI) calc(dd []float64, ma float64) float64 {
+	dnm := cci.factor * MeanDeviation(dd)
+	if dnm == 0 {
+		return 0
+	}
+
+	return (dd[len(dd)-1] - ma) / dnm
+}
+
+// Count determines the total amount of data points needed for CCI
+// calculation.
+func (cci CCI) Count() int {
+	return cci.ma.Count()
+}
+
+// CalcSeries calculates CCI for every window of Count() data points of
+// the provided slice.
+func (cci CCI) CalcSeries(dd []float64) ([]float64, error) {
+	if !cci.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < cci.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res, err := CalcMASeries(cci.ma, dd)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range res {
+		res[i] = cci.calc(dd[i:i+cci.Count()], res[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new CCI stream that calculates CCI from the most recent
+// data points each time a new data point is added.
+func (cci CCI) Stream() (*tango.Stream[float64, float64], error) {
+	if !cci.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(cci.Count(), cci.Calc)
+}
+
+// CalcSeriesCandles calculates CCI for every window of Count() candles of
+// the provided slice. Typical price of each candle is used, so the values
+// are identical to the ones CalcCandles produces.
+func (cci CCI) CalcSeriesCandles(cc []Candle) ([]float64, error) {
+	if !cci.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return cci.CalcSeries(typicalPrices(cc))
+}
+
+// StreamCandles creates new CCI stream that calculates CCI from the
+// typical prices of the most recent candles each time a new candle is
+// added.
+func (cci CCI) StreamCandles() (*tango.Stream[Candle, float64], error) {
+	if !cci.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(cci.Count(), cci.CalcCandles)
+}
+
+// FibonacciLevels holds all the necessary information needed to calculate
+// fibonacci levels.
+// The zero value is not usable.
+type FibonacciLevels struct {
+	// valid specifies whether FibonacciLevels paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewFibonacciLevels validates provided configuration options and
+// creates new FibonacciLevels indicator instance.
+func NewFibonacciLevels(length int) (FibonacciLevels, error) {
+	fl := FibonacciLevels{
+		length: length,
+	}
+
+	if err := fl.validate(); err != nil {
+		return FibonacciLevels{}, err
+	}
+
+	return fl, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (fl *FibonacciLevels) validate() error {
+	if fl.length < 2 {
+		return tango.ErrInvalidLength
+	}
+
+	fl.valid = true
+
+	return nil
+}
+
+// Calc calculates fibonacci level from the provided data points slice.
+// Trend is not considered, just like in tango.
+func (fl FibonacciLevels) Calc(level float64, dd []float64) (float64, error) {
+	if !fl.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if level > 1 || level < 0 {
+		return 0, tango.ErrInvalidLevel
+	}
+
+	if len(dd) != fl.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	minValue := dd[len(dd)-1]
+	maxValue := dd[len(dd)-1]
+
+	for i := len(dd) - 2; i >= 0; i-- {
+		minValue = math.Min(minValue, dd[i])
+		maxValue = math.Max(maxValue, dd[i])
+	}
+
+	return minValue + (maxValue-minValue)*level, nil
+}
+
+// Count determines the total amount of data points needed for fibonacci levels
+// calculation.
+func (fl FibonacciLevels) Count() int {
+	return fl.length
+}
+
+// CalcSeries calculates fibonacci level for every window of Count() data
+// points of the provided slice. Monotonic deques are used, so the
+// calculation takes linear time.
+func (fl FibonacciLevels) CalcSeries(level float64, dd []float64) ([]float64, error) {
+	if !fl.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if level > 1 || level < 0 {
+		return nil, tango.ErrInvalidLevel
+	}
+
+	if len(dd) < fl.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	lows, highs := rollingExtremes(dd, fl.Count())
+	res := make([]float64, len(lows))
+
+	for i := range res {
+		res[i] = lows[i] + (highs[i]-lows[i])*level
+	}
+
+	return res, nil
+}
+
+// Stream creates new FibonacciLevels stream that calculates the specified
+// fibonacci level from the most recent data points each time a new data
+// point is added.
+func (fl FibonacciLevels) Stream(level float64) (*tango.Stream[float64, float64], error) {
+	if !fl.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if level > 1 || level < 0 {
+		return nil, tango.ErrInvalidLevel
+	}
+
+	return tango.NewStream(fl.Count(), func(dd []float64) (float64, error) {
+		return fl.Calc(level, dd)
+	})
+}
+
+// MACDResult holds all values produced by a single MACD calculation.
+type MACDResult struct {
+	// MACD is the MACD line value.
+	MACD float64
+
+	// Signal is the signal line value.
+	Signal float64
+
+	// Histogram is the difference between MACD and signal line values.
+	Histogram float64
+}
+
+// MACD holds all the necessary information needed to calculate moving
+// average convergence divergence.
+// The zero value is not usable.
+type MACD struct {
+	// valid specifies whether MACD paremeters were validated.
+	valid bool
+
+	// fast specifies fast MA indicator configuration.
+	fast MA
+
+	// slow specifies slow MA indicator configuration.
+	slow MA
+
+	// signal specifies signal line MA indicator configuration.
+	signal MA
+}
+
+// NewMACD validates provided configuration options and creates new
+// MACD indicator. The same moving average type is used for fast, slow
+// and signal lines.
+func NewMACD(fast, slow, signal int, mat tango.MAType) (MACD, error) {
+	fastMA, err := NewMA(mat, fast)
+	if err != nil {
+		return MACD{}, err
+	}
+
+	slowMA, err := NewMA(mat, slow)
+	if err != nil {
+		return MACD{}, err
+	}
+
+	signalMA, err := NewMA(mat, signal)
+	if err != nil {
+		return MACD{}, err
+	}
+
+	macd := MACD{
+		fast:   fastMA,
+		slow:   slowMA,
+		signal: signalMA,
+	}
+
+	if err := macd.validate(); err != nil {
+		return MACD{}, err
+	}
+
+	return macd, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (macd *MACD) validate() error {
+	if macd.fast.Count() >= macd.slow.Count() {
+		return tango.ErrInvalidLength
+	}
+
+	macd.valid = true
+
+	return nil
+}
+
+// Calc calculates all MACD values from the provided data points slice.
+func (macd MACD) Calc(dd []float64) (line, signal, histogram float64, err error) {
+	if !macd.valid {
+		return 0, 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != macd.Count() {
+		return 0, 0, 0, tango.ErrInvalidDataSize
+	}
+
+	res, err := macd.calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, 0, err
+	}
+
+	return res[0].MACD, res[0].Signal, res[0].Histogram, nil
+}
+
+// CalcOutput calculates specified MACD value from the provided data
+// points slice.
+func (macd MACD) CalcOutput(dd []float64, output tango.MACDOutput) (float64, error) {
+	if err := output.Validate(); err != nil {
+		return 0, err
+	}
+
+	line, signal, histogram, err := macd.Calc(dd)
+	if err != nil {
+		return 0, err
+	}
+
+	switch output {
+	case tango.MACDOutputLine:
+		return line, nil
+	case tango.MACDOutputSignal:
+		return signal, nil
+	default: // output is validated, only tango.MACDOutputHistogram is left.
+		return histogram, nil
+	}
+}
+
+// calc calculates MACD values for every window of Count() data points of
+// the provided slice.
+func (macd MACD) calc(dd []float64) ([]MACDResult, error) {
+	fast, err := CalcMASeries(macd.fast, dd)
+	if err != nil {
+		return nil, err
+	}
+
+	slow, err := CalcMASeries(macd.slow, dd)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]float64, len(slow))
+
+	for i := range lines {
+		lines[i] = fast[len(fast)-len(slow)+i] - slow[i]
+	}
+
+	signals, err := CalcMASeries(macd.signal, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]MACDResult, len(signals))
+
+	for i := range res {
+		line := lines[len(lines)-len(signals)+i]
+
+		res[i] = MACDResult{
+			MACD:      line,
+			Signal:    signals[i],
+			Histogram: line - signals[i],
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for MACD
+// calculation, including the warm-up of the signal line.
+func (macd MACD) Count() int {
+	return macd.slow.Count() + macd.signal.Count() - 1
+}
+
+// CalcSeries calculates all MACD values for every window of Count() data
+// points of the provided slice. Every moving average value is calculated
+// from its own window, so the values are identical to the ones Calc
+// produces.
+func (macd MACD) CalcSeries(dd []float64) ([]MACDResult, error) {
+	if !macd.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < macd.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	return macd.calc(dd)
+}
+
+// Stream creates new MACD stream that calculates all MACD values from the
+// most recent data points each time a new data point is added.
+func (macd MACD) Stream() (*tango.Stream[float64, MACDResult], error) {
+	if !macd.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(macd.Count(), func(dd []float64) (MACDResult, error) {
+		line, signal, histogram, err := macd.Calc(dd)
+		if err != nil {
+			// unlikely to happen
+			return MACDResult{}, err
+		}
+
+		return MACDResult{
+			MACD:      line,
+			Signal:    signal,
+			Histogram: histogram,
+		}, nil
+	})
+}
+
+// ROC holds all the necessary information needed to calculate rate
+// of change.
+// The zero value is not usable.
+type ROC struct {
+	// valid specifies whether ROC paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewROC validates provided configuration options and
+// creates new ROC indicator.
+func NewROC(length int) (ROC, error) {
+	roc := ROC{length: length}
+
+	if err := roc.validate(); err != nil {
+		return ROC{}, err
+	}
+
+	return roc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (roc *ROC) validate() error {
+	if roc.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	roc.valid = true
+
+	return nil
+}
+
+// Calc calculates ROC from the provided data points slice.
+func (roc ROC) Calc(dd []float64) (float64, error) {
+	if !roc.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != roc.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	return roc.calc(dd[0], dd[len(dd)-1]), nil
+}
+
+// calc calculates ROC from the first and the last data points of
+// a window.
+func (roc ROC) calc(curr, last float64) float64 {
+	return (curr/last - 1) * 100
+}
+
+// Count determines the total amount of data points needed for ROC
+// calculation.
+func (roc ROC) Count() int {
+	return roc.length
+}
+
+// CalcSeries calculates ROC for every window of Count() data points of
+// the provided slice.
+func (roc ROC) CalcSeries(dd []float64) ([]float64, error) {
+	if !roc.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < roc.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res := make([]float64, len(dd)-roc.Count()+1)
+
+	for i := range res {
+		res[i] = roc.calc(dd[i], dd[i+roc.length-1])
+	}
+
+	return res, nil
+}
+
+// Stream creates new ROC stream that calculates ROC from the most recent
+// data points each time a new data point is added.
+func (roc ROC) Stream() (*tango.Stream[float64, float64], error) {
+	if !roc.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(roc.Count(), roc.Calc)
+}
+
+// RSI holds all the necessary information needed to calculate relative
+// strength index.
+// The zero value is not usable.
+type RSI struct {
+	// valid specifies whether RSI paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// ma specifies MA indicator configuration used to smooth average
+	// gains and losses. Simple averages over a single window are used
+	// when it is nil.
+	ma MA
+}
+
+// NewRSI validates provided configuration options and
+// creates new RSI indicator.
+func NewRSI(length int) (RSI, error) {
+	return NewRSIWithSmoothing(length, tango.RSISmoothingSimple)
+}
+
+// NewRSIWithSmoothing validates provided configuration options and
+// creates new RSI indicator that smooths average gains and losses.
+// tango.RSISmoothingSimple averages gains and losses over a single
+// window, tango.RSISmoothingWilder and tango.RSISmoothingExponential
+// smooth them with SMMA and EMA respectively. Just like in tango, every
+// window of 2*length data points is smoothed on its own, so the values
+// differ from the ones of charting platforms that smooth the whole
+// history continuously.
+func NewRSIWithSmoothing(length int, smoothing tango.RSISmoothing) (RSI, error) {
+	rsi := RSI{
+		length: length,
+	}
+
+	if err := rsi.validate(); err != nil {
+		return RSI{}, err
+	}
+
+	var err error
+
+	switch smoothing {
+	case tango.RSISmoothingSimple:
+		// simple averages don't need any moving average.
+	case tango.RSISmoothingWilder:
+		rsi.ma, err = NewSMMA(length)
+	case tango.RSISmoothingExponential:
+		rsi.ma, err = NewEMA(length)
+	default:
+		return RSI{}, tango.ErrInvalidRSISmoothing
+	}
+
+	if err != nil {
+		// unlikely to happen
+		return RSI{}, err
+	}
+
+	return rsi, nil
+}
+
+// NewRSIWithMA validates provided configuration options and creates new
+// RSI indicator that smooths average gains and losses with the provided
+// moving average type.
+func NewRSIWithMA(mat tango.MAType, length int) (RSI, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return RSI{}, err
+	}
+
+	rsi := RSI{
+		length: length,
+		ma:     ma,
+	}
+
+	if err := rsi.validate(); err != nil {
+		return RSI{}, err
+	}
+
+	return rsi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (rsi *RSI) validate() error {
+	if rsi.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	rsi.valid = true
+
+	return nil
+}
+
+// Calc calculates RSI from the provided data points slice.
+func (rsi RSI) Calc(dd []float64) (float64, error) {
+	if !rsi.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != rsi.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	if rsi.ma != nil {
+		gg, ll := rsiChanges(dd)
+
+		ag, err := rsi.ma.Calc(gg)
+		if err != nil {
+			// unlikely to happen
+			return 0, err
+		}
+
+		al, err := rsi.ma.Calc(ll)
+		if err != nil {
+			// unlikely to happen
+			return 0, err
+		}
+
+		return rsi.calcSmoothed(ag, al), nil
+	}
+
+	var (
+		ag, al        float64
+		gains, losses int
+	)
+
+	for i := 1; i < len(dd); i++ {
+		if chg := dd[i] - dd[i-1]; chg < 0 {
+			al -= chg
+			losses++
+		} else {
+			ag += chg
+			gains++
+		}
+	}
+
+	return rsi.calc(ag, al, gains, losses), nil
+}
+
+// calc calculates RSI from the total gain and loss of the data points and
+// the number of changes that contributed to them. Unchanged data points
+// are counted as gains.
+func (rsi RSI) calc(ag, al float64, gains, losses int) float64 {
+	if gains == 0 {
+		return 0
+	}
+
+	if losses == 0 {
+		return 100
+	}
+
+	length := float64(rsi.length)
+
+	return 100 - 100/(1+(ag/length)/(al/length))
+}
+
+// calcSmoothed calculates RSI from the smoothed average gain and loss.
+func (rsi RSI) calcSmoothed(ag, al float64) float64 {
+	if al == 0 {
+		return 100
+	}
+
+	if ag == 0 {
+		return 0
+	}
+
+	return 100 - 100/(1+ag/al)
+}
+
+// Count determines the total amount of data points needed for RSI
+// calculation. Smoothed RSI includes the data points needed for the
+// moving average warm-up.
+func (rsi RSI) Count() int {
+	if rsi.ma != nil {
+		return rsi.ma.Count() + 1
+	}
+
+	return rsi.length
+}
+
+// CalcSeries calculates RSI for every window of Count() data points of
+// the provided slice. Rolling sums of gains and losses are used, so the
+// calculation takes linear time.
+func (rsi RSI) CalcSeries(dd []float64) ([]float64, error) {
+	if !rsi.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < rsi.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	if rsi.ma != nil {
+		return rsi.calcSmoothedSeries(dd)
+	}
+
+	res := make([]float64, len(dd)-rsi.Count()+1)
+
+	var (
+		ag, al        float64
+		gains, losses int
+	)
+
+	for i := range dd {
+		if i > 0 {
+			if chg := dd[i] - dd[i-1]; chg < 0 {
+				al -= chg
+				losses++
+			} else {
+				ag += chg
+				gains++
+			}
+		}
+
+		// The change that is no longer part of the window is removed.
+		if j := i - rsi.length + 1; j > 0 {
+			if chg := dd[j] - dd[j-1]; chg < 0 {
+				al += chg
+				losses--
+			} else {
+				ag -= chg
+				gains--
+			}
+		}
+
+		if i >= rsi.length-1 {
+			res[i-rsi.length+1] = rsi.calc(ag, al, gains, losses)
+		}
+	}
+
+	return res, nil
+}
+
+// calcSmoothedSeries calculates smoothed RSI for every window of Count()
+// data points of the provided slice.
+func (rsi RSI) calcSmoothedSeries(dd []float64) ([]float64, error) {
+	gg, ll := rsiChanges(dd)
+
+	ag, err := CalcMASeries(rsi.ma, gg)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	al, err := CalcMASeries(rsi.ma, ll)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	res := make([]float64, len(ag))
+
+	for i := range res {
+		res[i] = rsi.calcSmoothed(ag[i], al[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new RSI stream that calculates RSI from the most recent
+// data points each time a new data point is added.
+func (rsi RSI) Stream() (*tango.Stream[float64, float64], error) {
+	if !rsi.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(rsi.Count(), rsi.Calc)
+}
+
+// rsiChanges splits changes between consecutive data points into gains
+// and losses. Both slices contain a zero when the change doesn't belong
+// to them.
+func rsiChanges(dd []float64) (gains, losses []float64) {
+	if len(dd) < 2 {
+		return nil, nil
+	}
+
+	gains = make([]float64, len(dd)-1)
+	losses = make([]float64, len(dd)-1)
+
+	for i := range gains {
+		if chg := dd[i+1] - dd[i]; chg < 0 {
+			losses[i] = -chg
+		} else {
+			gains[i] = chg
+		}
+	}
+
+	return gains, losses
+}
+
+// StochRSIResult holds both lines produced by a single StochRSI
+// calculation.
+type StochRSIResult struct {
+	// K is the smoothed %K line value.
+	K float64
+
+	// D is the %D signal line value.
+	D float64
+}
+
+// StochRSI holds all the necessary information needed to calculate stoch
+// relative strength index.
+// The zero value is not usable.
+type StochRSI struct {
+	// valid specifies whether StochRSI paremeters were validated.
+	valid bool
+
+	// rsi specifies the base relative strength index.
+	rsi RSI
+
+	// length specifies how many RSI values should be used to find
+	// the lowest and the highest RSI.
+	length int
+
+	// k specifies MA indicator configuration used to smooth %K line.
+	k MA
+
+	// d specifies MA indicator configuration used to calculate %D line.
+	d MA
+}
+
+// NewStochRSI validates provided configuration options and
+// creates new StochRSI indicator. Simple RSI of the given length is
+// used and the same amount of RSI values is compared.
+func NewStochRSI(length int) (StochRSI, error) {
+	rsi, err := NewRSI(length)
+	if err != nil {
+		return StochRSI{}, err
+	}
+
+	line, err := NewSMA(1)
+	if err != nil {
+		// unlikely to happen
+		return StochRSI{}, err
+	}
+
+	s := StochRSI{
+		rsi:    rsi,
+		length: length,
+		k:      line,
+		d:      line,
+	}
+
+	if err := s.validate(); err != nil {
+		return StochRSI{}, err
+	}
+
+	return s, nil
+}
+
+// NewStochRSIWithLines validates provided configuration options and
+// creates new StochRSI indicator that smooths %K and %D lines. Wilder
+// smoothed RSI is used and the same moving average type is used for %K
+// smoothing and %D line.
+func NewStochRSIWithLines(rsiLength, stochLength, kSmoothing, dLength int, mat tango.MAType) (StochRSI, error) {
+	rsi, err := NewRSIWithSmoothing(rsiLength, tango.RSISmoothingWilder)
+	if err != nil {
+		return StochRSI{}, err
+	}
+
+	k, err := NewMA(mat, kSmoothing)
+	if err != nil {
+		return StochRSI{}, err
+	}
+
+	d, err := NewMA(mat, dLength)
+	if err != nil {
+		return StochRSI{}, err
+	}
+
+	s := StochRSI{
+		rsi:    rsi,
+		length: stochLength,
+		k:      k,
+		d:      d,
+	}
+
+	if err := s.validate(); err != nil {
+		return StochRSI{}, err
+	}
+
+	return s, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (s *StochRSI) validate() error {
+	if s.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	s.valid = true
+
+	return nil
+}
+
+// Calc calculates StochRSI from the provided data slice. The result is in
+// range from 0 to 1.
+func (s StochRSI) Calc(dd []float64) (float64, error) {
+	if !s.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != s.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return 0, err
+	}
+
+	return s.calcValues(rr)[0], nil
+}
+
+// CalcLines calculates both %K and %D lines from the provided data slice.
+// The results are in range from 0 to 100.
+func (s StochRSI) CalcLines(dd []float64) (k, d float64, err error) {
+	if !s.valid {
+		return 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != s.CountLines() {
+		return 0, 0, tango.ErrInvalidDataSize
+	}
+
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, err
+	}
+
+	res, err := s.calcLines(rr)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, err
+	}
+
+	return res[0].K, res[0].D, nil
+}
+
+// calc calculates StochRSI from the current, the lowest and the highest
+// RSI values.
+func (s StochRSI) calc(curr, minValue, maxValue float64) float64 {
+	if maxValue == minValue {
+		return 0
+	}
+
+	return (curr - minValue) / (maxValue - minValue)
+}
+
+// calcValues calculates StochRSI for every window of the provided RSI
+// values.
+func (s StochRSI) calcValues(rr []float64) []float64 {
+	lows, highs := rollingExtremes(rr, s.length)
+	res := make([]float64, len(lows))
+
+	for i := range res {
+		res[i] = s.calc(rr[i+s.length-1], lows[i], highs[i])
+	}
+
+	return res
+}
+
+// calcLines calculates both lines for every window of the provided RSI
+// values.
+func (s StochRSI) calcLines(rr []float64) ([]StochRSIResult, error) {
+	raw := s.calcValues(rr)
+
+	for i := range raw {
+		raw[i] *= 100
+	}
+
+	kk, err := CalcMASeries(s.k, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dd, err := CalcMASeries(s.d, kk)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]StochRSIResult, len(dd))
+
+	for i := range res {
+		res[i] = StochRSIResult{
+			K: kk[len(kk)-len(dd)+i],
+			D: dd[i],
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data needed for StochRSI
+// calculation.
+func (s StochRSI) Count() int {
+	return s.rsi.Count() + s.length - 1
+}
+
+// CountLines determines the total amount of data needed for the
+// calculation of both lines, including their warm-up.
+func (s StochRSI) CountLines() int {
+	return s.Count() + s.k.Count() + s.d.Count() - 2
+}
+
+// CalcSeries calculates StochRSI for every window of Count() data points
+// of the provided slice. The values are identical to the ones Calc
+// produces.
+func (s StochRSI) CalcSeries(dd []float64) ([]float64, error) {
+	if !s.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < s.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return s.calcValues(rr), nil
+}
+
+// CalcSeriesLines calculates both %K and %D lines for every window of
+// CountLines() data points of the provided slice. The values are identical
+// to the ones CalcLines produces.
+func (s StochRSI) CalcSeriesLines(dd []float64) ([]StochRSIResult, error) {
+	if !s.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < s.CountLines() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return s.calcLines(rr)
+}
+
+// Stream creates new StochRSI stream that calculates StochRSI from the
+// most recent data points each time a new data point is added.
+func (s StochRSI) Stream() (*tango.Stream[float64, float64], error) {
+	if !s.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(s.Count(), s.Calc)
+}
+
+// StreamLines creates new StochRSI stream that calculates both %K and %D
+// lines from the most recent data points each time a new data point is
+// added.
+func (s StochRSI) StreamLines() (*tango.Stream[float64, StochRSIResult], error) {
+	if !s.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(s.CountLines(), func(dd []float64) (StochRSIResult, error) {
+		k, d, err := s.CalcLines(dd)
+		if err != nil {
+			// unlikely to happen
+			return StochRSIResult{}, err
+		}
+
+		return StochRSIResult{
+			K: k,
+			D: d,
+		}, nil
+	})
+}
+
+// Stoch holds all the necessary information needed to calculate stochastic
+// oscillator.
+// The zero value is not usable.
+type Stoch struct {
+	// valid specifies whether Stoch paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewStoch validates provided configuration options and
+// creates new Stoch indicator.
+func NewStoch(length int) (Stoch, error) {
+	stoch := Stoch{
+		length: length,
+	}
+
+	if err := stoch.validate(); err != nil {
+		return Stoch{}, err
+	}
+
+	return stoch, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (stoch *Stoch) validate() error {
+	if stoch.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	stoch.valid = true
+
+	return nil
+}
+
+// Calc calculates Stoch from the provided data points slice.
+func (stoch Stoch) Calc(dd []float64) (float64, error) {
+	if !stoch.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != stoch.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	low := dd[0]
+	high := dd[0]
+
+	for i := range dd {
+		low = math.Min(low, dd[i])
+		high = math.Max(high, dd[i])
+	}
+
+	return stoch.calc(dd[len(dd)-1], low, high), nil
+}
+
+// calc calculates Stoch from the last, the lowest and the highest data
+// point values.
+func (stoch Stoch) calc(last, low, high float64) float64 {
+	dnm := high - low
+	if dnm == 0 {
+		return 0
+	}
+
+	return (last - low) / dnm * 100
+}
+
+// Count determines the total amount of data points needed for Stoch
+// calculation.
+func (stoch Stoch) Count() int {
+	return stoch.length
+}
+
+// CalcSeries calculates Stoch for every window of Count() data points of
+// the provided slice. Monotonic deques are used, so the calculation takes
+// linear time.
+func (stoch Stoch) CalcSeries(dd []float64) ([]float64, error) {
+	if !stoch.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < stoch.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	lows, highs := rollingExtremes(dd, stoch.length)
+	res := make([]float64, len(lows))
+
+	for i := range res {
+		res[i] = stoch.calc(dd[i+stoch.length-1], lows[i], highs[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new Stoch stream that calculates Stoch from the most recent
+// data points each time a new data point is added.
+func (stoch Stoch) Stream() (*tango.Stream[float64, float64], error) {
+	if !stoch.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(stoch.Count(), stoch.Calc)
+}
+
+// FullStochResult holds both lines produced by a single FullStoch
+// calculation.
+type FullStochResult struct {
+	// K is the smoothed %K line value.
+	K float64
+
+	// D is the %D signal line value.
+	D float64
+}
+
+// FullStoch holds all the necessary information needed to calculate full
+// stochastic oscillator from candles.
+// The zero value is not usable.
+type FullStoch struct {
+	// valid specifies whether FullStoch paremeters were validated.
+	valid bool
+
+	// stoch specifies raw %K indicator configuration.
+	stoch Stoch
+
+	// k specifies MA indicator configuration used to smooth %K line.
+	k MA
+
+	// d specifies MA indicator configuration used to calculate %D line.
+	d MA
+}
+
+// NewFullStoch validates provided configuration options and creates new
+// FullStoch indicator. The same moving average type is used for %K
+// smoothing and %D line. Fast stochastic oscillator can be calculated
+// by setting kSmoothing to 1.
+func NewFullStoch(length, kSmoothing, dLength int, mat tango.MAType) (FullStoch, error) {
+	stoch, err := NewStoch(length)
+	if err != nil {
+		return FullStoch{}, err
+	}
+
+	k, err := NewMA(mat, kSmoothing)
+	if err != nil {
+		return FullStoch{}, err
+	}
+
+	d, err := NewMA(mat, dLength)
+	if err != nil {
+		return FullStoch{}, err
+	}
+
+	return FullStoch{
+		valid: true,
+		stoch: stoch,
+		k:     k,
+		d:     d,
+	}, nil
+}
+
+// Calc calculates both %K and %D lines from the provided candles slice.
+// The highest high and the lowest low of the candles are used.
+func (fs FullStoch) Calc(cc []Candle) (k, d float64, err error) {
+	if !fs.valid {
+		return 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != fs.Count() {
+		return 0, 0, tango.ErrInvalidDataSize
+	}
+
+	res, err := fs.calc(cc)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, err
+	}
+
+	return res[0].K, res[0].D, nil
+}
+
+// calc calculates both lines for every window of Count() candles of the
+// provided slice.
+func (fs FullStoch) calc(cc []Candle) ([]FullStochResult, error) {
+	lows := make([]float64, len(cc))
+	highs := make([]float64, len(cc))
+
+	for i := range cc {
+		lows[i] = cc[i].Low
+		highs[i] = cc[i].High
+	}
+
+	lows, _ = rollingExtremes(lows, fs.stoch.length)
+	_, highs = rollingExtremes(highs, fs.stoch.length)
+
+	raw := make([]float64, len(lows))
+
+	for i := range raw {
+		raw[i] = fs.stoch.calc(cc[i+fs.stoch.length-1].Close, lows[i], highs[i])
+	}
+
+	kk, err := CalcMASeries(fs.k, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dd, err := CalcMASeries(fs.d, kk)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]FullStochResult, len(dd))
+
+	for i := range res {
+		res[i] = FullStochResult{
+			K: kk[len(kk)-len(dd)+i],
+			D: dd[i],
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for FullStoch
+// calculation, including the warm-up of both lines.
+func (fs FullStoch) Count() int {
+	return fs.stoch.Count() + fs.k.Count() + fs.d.Count() - 2
+}
+
+// CalcSeries calculates both %K and %D lines for every window of Count()
+// candles of the provided slice. The values are identical to the ones Calc
+// produces.
+func (fs FullStoch) CalcSeries(cc []Candle) ([]FullStochResult, error) {
+	if !fs.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < fs.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	return fs.calc(cc)
+}
+
+// Stream creates new FullStoch stream that calculates both %K and %D
+// lines from the most recent candles each time a new candle is added.
+func (fs FullStoch) Stream() (*tango.Stream[Candle, FullStochResult], error) {
+	if !fs.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(fs.Count(), func(cc []Candle) (FullStochResult, error) {
+		k, d, err := fs.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return FullStochResult{}, err
+		}
+
+		return FullStochResult{
+			K: k,
+			D: d,
+		}, nil
+	})
+}
@@ -0,0 +1,1305 @@
+package fast
+
+import (
+	"testing"
+
+	"github.com/jellydator/tango"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewADX(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ADX
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new ADX": {
+			Length: 3,
+			Result: ADX{
+				valid: true,
+				smma:  SMMA{valid: true, sma: SMA{valid: true, length: 3}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewADX(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ADX_CrossCheck(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	_, _, _, err := ADX{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ADX{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ADX{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tadx, err := tango.NewADX(5)
+	assert.NoError(t, err)
+
+	adx, err := NewADX(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, tadx.Count(), adx.Count())
+
+	_, _, _, err = adx.Calc(cc[:adx.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = adx.CalcSeries(cc[:adx.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = adx.CalcTrend(cc[:adx.Count()], 70)
+	assertEqualError(t, tango.ErrInvalidTrend, err)
+
+	for i := adx.Count(); i <= len(cc); i++ {
+		expADX, expPlus, expMinus, err := tadx.Calc(tcc[i-adx.Count() : i])
+		assert.NoError(t, err)
+
+		resADX, resPlus, resMinus, err := adx.Calc(cc[i-adx.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, expADX, resADX)
+		assertCloseTo(t, expPlus, resPlus)
+		assertCloseTo(t, expMinus, resMinus)
+
+		res, err := adx.CalcTrend(cc[i-adx.Count():i], tango.TrendUp)
+		assert.NoError(t, err)
+		assert.Equal(t, resPlus, res)
+
+		res, err = adx.CalcTrend(cc[i-adx.Count():i], tango.TrendDown)
+		assert.NoError(t, err)
+		assert.Equal(t, resMinus, res)
+	}
+
+	exp, err := tadx.CalcSeries(tcc)
+	assert.NoError(t, err)
+
+	res, err := adx.CalcSeries(cc)
+	assert.NoError(t, err)
+
+	if assert.Len(t, res, len(exp)) {
+		for i := range exp {
+			assertCloseTo(t, exp[i].ADX, res[i].ADX)
+			assertCloseTo(t, exp[i].PlusDI, res[i].PlusDI)
+			assertCloseTo(t, exp[i].MinusDI, res[i].MinusDI)
+		}
+	}
+
+	s, err := adx.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, adx.Count(), func(cc []Candle) (ADXResult, error) {
+		adxv, plusDI, minusDI, err := adx.Calc(cc)
+		return ADXResult{ADX: adxv, PlusDI: plusDI, MinusDI: minusDI}, err
+	}, cc)
+}
+
+func Test_NewAroon(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Aroon
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new Aroon": {
+			Length: 5,
+			Result: Aroon{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewAroon(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Aroon_CrossCheck(t *testing.T) {
+	_, _, err := Aroon{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = Aroon{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = Aroon{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	taroon, err := tango.NewAroon(5)
+	assert.NoError(t, err)
+
+	aroon, err := NewAroon(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, taroon.Count(), aroon.Count())
+
+	// Repeated data points and reversals are appended to verify that the
+	// walk stops at the first reversal.
+	dd := crossCheckData()
+	dd = append(dd, 70, 70, 69, 70, 60, 61, 60, 65)
+	tdd := decimals(dd)
+
+	_, _, err = aroon.Calc(dd[:aroon.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = aroon.CalcSeries(dd[:aroon.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = aroon.CalcTrend(dd[:aroon.Count()], 70)
+	assertEqualError(t, tango.ErrInvalidTrend, err)
+
+	for i := aroon.Count(); i <= len(dd); i++ {
+		expUp, expDown, err := taroon.Calc(tdd[i-aroon.Count() : i])
+		assert.NoError(t, err)
+
+		resUp, resDown, err := aroon.Calc(dd[i-aroon.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, expUp, resUp)
+		assertCloseTo(t, expDown, resDown)
+
+		res, err := aroon.CalcTrend(dd[i-aroon.Count():i], tango.TrendUp)
+		assert.NoError(t, err)
+		assert.Equal(t, resUp, res)
+
+		res, err = aroon.CalcTrend(dd[i-aroon.Count():i], tango.TrendDown)
+		assert.NoError(t, err)
+		assert.Equal(t, resDown, res)
+	}
+
+	exp, err := taroon.CalcSeries(tdd)
+	assert.NoError(t, err)
+
+	res, err := aroon.CalcSeries(dd)
+	assert.NoError(t, err)
+
+	if assert.Len(t, res, len(exp)) {
+		for i := range exp {
+			assertCloseTo(t, exp[i].Uptrend, res[i].Uptrend)
+			assertCloseTo(t, exp[i].Downtrend, res[i].Downtrend)
+		}
+	}
+
+	s, err := aroon.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, aroon.Count(), func(dd []float64) (AroonResult, error) {
+		uptrend, downtrend, err := aroon.Calc(dd)
+		return AroonResult{Uptrend: uptrend, Downtrend: downtrend}, err
+	}, dd)
+}
+
+func Test_NewCCI(t *testing.T) {
+	cc := map[string]struct {
+		MAType tango.MAType
+		Length int
+		Result CCI
+		Error  error
+	}{
+		"Invalid MA type": {
+			MAType: 70,
+			Length: 3,
+			Error:  tango.ErrInvalidMA,
+		},
+		"Successfully created new CCI": {
+			MAType: tango.MATypeSimple,
+			Length: 3,
+			Result: CCI{
+				valid:  true,
+				ma:     SMA{valid: true, length: 3},
+				factor: 0.015,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCCI(c.MAType, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewCCIWithFactor(t *testing.T) {
+	cc := map[string]struct {
+		MAType tango.MAType
+		Length int
+		Factor float64
+		Result CCI
+		Error  error
+	}{
+		"Invalid MA type": {
+			MAType: 70,
+			Length: 3,
+			Error:  tango.ErrInvalidMA,
+		},
+		"Invalid factor": {
+			MAType: tango.MATypeSimple,
+			Length: 3,
+			Factor: -1,
+			Error:  tango.ErrInvalidFactor,
+		},
+		"Successfully created new CCI with default factor": {
+			MAType: tango.MATypeSimple,
+			Length: 3,
+			Result: CCI{
+				valid:  true,
+				ma:     SMA{valid: true, length: 3},
+				factor: 0.015,
+			},
+		},
+		"Successfully created new CCI": {
+			MAType: tango.MATypeSimple,
+			Length: 3,
+			Factor: 0.02,
+			Result: CCI{
+				valid:  true,
+				ma:     SMA{valid: true, length: 3},
+				factor: 0.02,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCCIWithFactor(c.MAType, c.Length, c.Factor)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CCI_CrossCheck(t *testing.T) {
+	_, err := CCI{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = CCI{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = CCI{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = CCI{}.CalcSeriesCandles(nil)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = CCI{}.StreamCandles()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	for _, mat := range []tango.MAType{tango.MATypeSimple, tango.MATypeExponential} {
+		tcci, err := tango.NewCCI(mat, 5)
+		assert.NoError(t, err)
+
+		cci, err := NewCCI(mat, 5)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tcci.Count(), cci.Count())
+
+		dd := crossCheckData()
+		tdd := decimals(dd)
+		cc, tcc := crossCheckCandles()
+
+		_, err = cci.Calc(dd[:cci.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		_, err = cci.CalcSeries(dd[:cci.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		res, err := cci.Calc(make([]float64, cci.Count()))
+		assert.NoError(t, err)
+		assert.Equal(t, 0.0, res)
+
+		for i := cci.Count(); i <= len(dd); i++ {
+			exp, err := tcci.Calc(tdd[i-cci.Count() : i])
+			assert.NoError(t, err)
+
+			res, err := cci.Calc(dd[i-cci.Count() : i])
+			assert.NoError(t, err)
+			assertCloseTo(t, exp, res)
+
+			exp, err = tcci.CalcCandles(tcc[i-cci.Count() : i])
+			assert.NoError(t, err)
+
+			res, err = cci.CalcCandles(cc[i-cci.Count() : i])
+			assert.NoError(t, err)
+			assertCloseTo(t, exp, res)
+		}
+
+		exp, err := tcci.CalcSeries(tdd)
+		assert.NoError(t, err)
+
+		ress, err := cci.CalcSeries(dd)
+		assert.NoError(t, err)
+		assertSeriesCloseTo(t, exp, ress)
+
+		exp, err = tcci.CalcSeriesCandles(tcc)
+		assert.NoError(t, err)
+
+		ress, err = cci.CalcSeriesCandles(cc)
+		assert.NoError(t, err)
+		assertSeriesCloseTo(t, exp, ress)
+
+		s, err := cci.Stream()
+		assert.NoError(t, err)
+		assertStreamMatchesCalc(t, s, cci.Count(), cci.Calc, dd)
+
+		sc, err := cci.StreamCandles()
+		assert.NoError(t, err)
+		assertStreamMatchesCalc(t, sc, cci.Count(), cci.CalcCandles, cc)
+	}
+}
+
+func Test_NewFibonacciLevels(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result FibonacciLevels
+		Error  error
+	}{
+		"Invalid length": {
+			Length: 1,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Successfully created new FibonacciLevels": {
+			Length: 5,
+			Result: FibonacciLevels{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewFibonacciLevels(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_FibonacciLevels_CrossCheck(t *testing.T) {
+	_, err := FibonacciLevels{}.Calc(0.5, crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = FibonacciLevels{}.CalcSeries(0.5, crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = FibonacciLevels{}.Stream(0.5)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tfl, err := tango.NewFibonacciLevels(5)
+	assert.NoError(t, err)
+
+	fl, err := NewFibonacciLevels(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, tfl.Count(), fl.Count())
+
+	dd := crossCheckData()
+	tdd := decimals(dd)
+
+	for _, level := range []float64{-0.1, 1.1} {
+		_, err = fl.Calc(level, dd[:fl.Count()])
+		assertEqualError(t, tango.ErrInvalidLevel, err)
+
+		_, err = fl.CalcSeries(level, dd)
+		assertEqualError(t, tango.ErrInvalidLevel, err)
+
+		_, err = fl.Stream(level)
+		assertEqualError(t, tango.ErrInvalidLevel, err)
+	}
+
+	_, err = fl.Calc(0.5, dd[:fl.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = fl.CalcSeries(0.5, dd[:fl.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	for _, level := range []float64{0, 0.236, 0.382, 0.5, 0.618, 1} {
+		tlevel := decimal.NewFromFloat(level)
+
+		for i := fl.Count(); i <= len(dd); i++ {
+			exp, err := tfl.Calc(tlevel, tdd[i-fl.Count():i])
+			assert.NoError(t, err)
+
+			res, err := fl.Calc(level, dd[i-fl.Count():i])
+			assert.NoError(t, err)
+			assertCloseTo(t, exp, res)
+		}
+
+		exp, err := tfl.CalcSeries(tlevel, tdd)
+		assert.NoError(t, err)
+
+		res, err := fl.CalcSeries(level, dd)
+		assert.NoError(t, err)
+		assertSeriesCloseTo(t, exp, res)
+
+		s, err := fl.Stream(level)
+		assert.NoError(t, err)
+		assertStreamMatchesCalc(t, s, fl.Count(), func(dd []float64) (float64, error) {
+			return fl.Calc(level, dd)
+		}, dd)
+	}
+}
+
+func Test_NewMACD(t *testing.T) {
+	cc := map[string]struct {
+		Fast   int
+		Slow   int
+		Signal int
+		MAType tango.MAType
+		Result MACD
+		Error  error
+	}{
+		"Invalid fast MA": {
+			Slow:   5,
+			Signal: 3,
+			MAType: tango.MATypeSimple,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Invalid slow MA": {
+			Fast:   3,
+			Signal: 3,
+			MAType: tango.MATypeSimple,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Invalid signal MA": {
+			Fast:   3,
+			Slow:   5,
+			MAType: tango.MATypeSimple,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Invalid MA type": {
+			Fast:   3,
+			Slow:   5,
+			Signal: 3,
+			MAType: 70,
+			Error:  tango.ErrInvalidMA,
+		},
+		"Fast MA is not faster than slow MA": {
+			Fast:   5,
+			Slow:   5,
+			Signal: 3,
+			MAType: tango.MATypeSimple,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Successfully created new MACD": {
+			Fast:   3,
+			Slow:   5,
+			Signal: 2,
+			MAType: tango.MATypeSimple,
+			Result: MACD{
+				valid:  true,
+				fast:   SMA{valid: true, length: 3},
+				slow:   SMA{valid: true, length: 5},
+				signal: SMA{valid: true, length: 2},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMACD(c.Fast, c.Slow, c.Signal, c.MAType)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_MACD_CrossCheck(t *testing.T) {
+	_, _, _, err := MACD{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = MACD{}.CalcOutput(crossCheckData(), tango.MACDOutputLine)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = MACD{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = MACD{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	outputs := []tango.MACDOutput{
+		tango.MACDOutputLine,
+		tango.MACDOutputSignal,
+		tango.MACDOutputHistogram,
+	}
+
+	for _, mat := range []tango.MAType{tango.MATypeSimple, tango.MATypeExponential} {
+		tmacd, err := tango.NewMACD(3, 6, 4, mat)
+		assert.NoError(t, err)
+
+		macd, err := NewMACD(3, 6, 4, mat)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tmacd.Count(), macd.Count())
+
+		dd := crossCheckData()
+		tdd := decimals(dd)
+
+		_, _, _, err = macd.Calc(dd[:macd.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		_, err = macd.CalcOutput(dd[:macd.Count()], 70)
+		assertEqualError(t, tango.ErrInvalidMACDOutput, err)
+
+		_, err = macd.CalcSeries(dd[:macd.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		for i := macd.Count(); i <= len(dd); i++ {
+			expLine, expSignal, expHistogram, err := tmacd.Calc(tdd[i-macd.Count() : i])
+			assert.NoError(t, err)
+
+			line, signal, histogram, err := macd.Calc(dd[i-macd.Count() : i])
+			assert.NoError(t, err)
+			assertCloseTo(t, expLine, line)
+			assertCloseTo(t, expSignal, signal)
+			assertCloseTo(t, expHistogram, histogram)
+
+			for _, output := range outputs {
+				exp, err := tmacd.CalcOutput(tdd[i-macd.Count():i], output)
+				assert.NoError(t, err)
+
+				res, err := macd.CalcOutput(dd[i-macd.Count():i], output)
+				assert.NoError(t, err)
+				assertCloseTo(t, exp, res)
+			}
+		}
+
+		exp, err := tmacd.CalcSeries(tdd)
+		assert.NoError(t, err)
+
+		res, err := macd.CalcSeries(dd)
+		assert.NoError(t, err)
+
+		if assert.Len(t, res, len(exp)) {
+			for i := range exp {
+				assertCloseTo(t, exp[i].MACD, res[i].MACD)
+				assertCloseTo(t, exp[i].Signal, res[i].Signal)
+				assertCloseTo(t, exp[i].Histogram, res[i].Histogram)
+			}
+		}
+
+		s, err := macd.Stream()
+		assert.NoError(t, err)
+		assertStreamMatchesCalc(t, s, macd.Count(), func(dd []float64) (MACDResult, error) {
+			line, signal, histogram, err := macd.Calc(dd)
+
+			return MACDResult{
+				MACD:      line,
+				Signal:    signal,
+				Histogram: histogram,
+			}, err
+		}, dd)
+	}
+}
+
+func Test_NewROC(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ROC
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new ROC": {
+			Length: 3,
+			Result: ROC{valid: true, length: 3},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewROC(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ROC_CrossCheck(t *testing.T) {
+	_, err := ROC{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ROC{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ROC{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	troc, err := tango.NewROC(5)
+	assert.NoError(t, err)
+
+	roc, err := NewROC(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, troc.Count(), roc.Count())
+
+	dd := crossCheckData()
+	tdd := decimals(dd)
+
+	_, err = roc.Calc(dd[:roc.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = roc.CalcSeries(dd[:roc.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	for i := roc.Count(); i <= len(dd); i++ {
+		exp, err := troc.Calc(tdd[i-roc.Count() : i])
+		assert.NoError(t, err)
+
+		res, err := roc.Calc(dd[i-roc.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, exp, res)
+	}
+
+	exp, err := troc.CalcSeries(tdd)
+	assert.NoError(t, err)
+
+	res, err := roc.CalcSeries(dd)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, res)
+
+	s, err := roc.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, roc.Count(), roc.Calc, dd)
+}
+
+func Test_NewRSI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result RSI
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new RSI": {
+			Length: 3,
+			Result: RSI{valid: true, length: 3},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRSI(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewRSIWithSmoothing(t *testing.T) {
+	cc := map[string]struct {
+		Length    int
+		Smoothing tango.RSISmoothing
+		Result    RSI
+		Error     error
+	}{
+		"Invalid smoothing": {
+			Length:    3,
+			Smoothing: 70,
+			Error:     tango.ErrInvalidRSISmoothing,
+		},
+		"Invalid length": {
+			Smoothing: tango.RSISmoothingSimple,
+			Error:     tango.ErrInvalidLength,
+		},
+		"Successfully created new simple RSI": {
+			Length:    3,
+			Smoothing: tango.RSISmoothingSimple,
+			Result:    RSI{valid: true, length: 3},
+		},
+		"Successfully created new Wilder's RSI": {
+			Length:    3,
+			Smoothing: tango.RSISmoothingWilder,
+			Result: RSI{
+				valid:  true,
+				length: 3,
+				ma:     SMMA{valid: true, sma: SMA{valid: true, length: 3}},
+			},
+		},
+		"Successfully created new exponential RSI": {
+			Length:    3,
+			Smoothing: tango.RSISmoothingExponential,
+			Result: RSI{
+				valid:  true,
+				length: 3,
+				ma:     EMA{valid: true, sma: SMA{valid: true, length: 3}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRSIWithSmoothing(c.Length, c.Smoothing)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewRSIWithMA(t *testing.T) {
+	cc := map[string]struct {
+		Type   tango.MAType
+		Length int
+		Result RSI
+		Error  error
+	}{
+		"Invalid moving average type": {
+			Length: 3,
+			Error:  tango.ErrInvalidMA,
+		},
+		"Invalid length": {
+			Type:  tango.MATypeSmoothed,
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new RSI": {
+			Type:   tango.MATypeSmoothed,
+			Length: 3,
+			Result: RSI{
+				valid:  true,
+				length: 3,
+				ma:     SMMA{valid: true, sma: SMA{valid: true, length: 3}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRSIWithMA(c.Type, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_RSI_CrossCheck(t *testing.T) {
+	_, err := RSI{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = RSI{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = RSI{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	cc := map[string]tango.RSISmoothing{
+		"Simple":      tango.RSISmoothingSimple,
+		"Wilder":      tango.RSISmoothingWilder,
+		"Exponential": tango.RSISmoothingExponential,
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			trsi, err := tango.NewRSIWithSmoothing(5, c)
+			assert.NoError(t, err)
+
+			rsi, err := NewRSIWithSmoothing(5, c)
+			assert.NoError(t, err)
+
+			assert.Equal(t, trsi.Count(), rsi.Count())
+
+			// Rising and falling data points are appended to verify that
+			// both ends of the scale match.
+			dd := crossCheckData()
+			dd = append(dd, 70, 71, 72, 73, 74, 75, 76, 77, 78, 77, 76, 75, 74, 73, 72, 71, 70, 69, 68)
+			tdd := decimals(dd)
+
+			_, err = rsi.Calc(dd[:rsi.Count()-1])
+			assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+			_, err = rsi.CalcSeries(dd[:rsi.Count()-1])
+			assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+			for i := rsi.Count(); i <= len(dd); i++ {
+				exp, err := trsi.Calc(tdd[i-rsi.Count() : i])
+				assert.NoError(t, err)
+
+				res, err := rsi.Calc(dd[i-rsi.Count() : i])
+				assert.NoError(t, err)
+				assertCloseTo(t, exp, res)
+			}
+
+			exp, err := trsi.CalcSeries(tdd)
+			assert.NoError(t, err)
+
+			res, err := rsi.CalcSeries(dd)
+			assert.NoError(t, err)
+			assertSeriesCloseTo(t, exp, res)
+
+			s, err := rsi.Stream()
+			assert.NoError(t, err)
+			assertStreamMatchesCalc(t, s, rsi.Count(), rsi.Calc, dd)
+		})
+	}
+}
+
+func Test_NewStochRSI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result StochRSI
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new StochRSI": {
+			Length: 14,
+			Result: StochRSI{
+				valid:  true,
+				rsi:    RSI{valid: true, length: 14},
+				length: 14,
+				k:      SMA{valid: true, length: 1},
+				d:      SMA{valid: true, length: 1},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewStochRSI(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewStochRSIWithLines(t *testing.T) {
+	cc := map[string]struct {
+		RSILength   int
+		StochLength int
+		KSmoothing  int
+		DLength     int
+		Type        tango.MAType
+		Result      StochRSI
+		Error       error
+	}{
+		"Invalid RSI length": {
+			StochLength: 14,
+			KSmoothing:  3,
+			DLength:     3,
+			Type:        tango.MATypeSimple,
+			Error:       tango.ErrInvalidLength,
+		},
+		"Invalid stoch length": {
+			RSILength:  14,
+			KSmoothing: 3,
+			DLength:    3,
+			Type:       tango.MATypeSimple,
+			Error:      tango.ErrInvalidLength,
+		},
+		"Invalid %K smoothing length": {
+			RSILength:   14,
+			StochLength: 14,
+			DLength:     3,
+			Type:        tango.MATypeSimple,
+			Error:       tango.ErrInvalidLength,
+		},
+		"Invalid %D length": {
+			RSILength:   14,
+			StochLength: 14,
+			KSmoothing:  3,
+			Type:        tango.MATypeSimple,
+			Error:       tango.ErrInvalidLength,
+		},
+		"Invalid MA type": {
+			RSILength:   14,
+			StochLength: 14,
+			KSmoothing:  3,
+			DLength:     3,
+			Error:       tango.ErrInvalidMA,
+		},
+		"Successfully created new StochRSI": {
+			RSILength:   14,
+			StochLength: 14,
+			KSmoothing:  3,
+			DLength:     3,
+			Type:        tango.MATypeSimple,
+			Result: StochRSI{
+				valid: true,
+				rsi: RSI{
+					valid:  true,
+					length: 14,
+					ma:     SMMA{valid: true, sma: SMA{valid: true, length: 14}},
+				},
+				length: 14,
+				k:      SMA{valid: true, length: 3},
+				d:      SMA{valid: true, length: 3},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewStochRSIWithLines(c.RSILength, c.StochLength, c.KSmoothing, c.DLength, c.Type)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_StochRSI_CrossCheck(t *testing.T) {
+	_, err := StochRSI{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, _, err = StochRSI{}.CalcLines(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = StochRSI{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = StochRSI{}.CalcSeriesLines(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = StochRSI{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = StochRSI{}.StreamLines()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	dd := crossCheckData()
+	tdd := decimals(dd)
+
+	ts, err := tango.NewStochRSI(5)
+	assert.NoError(t, err)
+
+	s, err := NewStochRSI(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, ts.Count(), s.Count())
+
+	_, err = s.Calc(dd[:s.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = s.CalcSeries(dd[:s.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	for i := s.Count(); i <= len(dd); i++ {
+		exp, err := ts.Calc(tdd[i-s.Count() : i])
+		assert.NoError(t, err)
+
+		res, err := s.Calc(dd[i-s.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, exp, res)
+	}
+
+	exp, err := ts.CalcSeries(tdd)
+	assert.NoError(t, err)
+
+	res, err := s.CalcSeries(dd)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, res)
+
+	st, err := s.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, st, s.Count(), s.Calc, dd)
+
+	for _, mat := range []tango.MAType{tango.MATypeSimple, tango.MATypeExponential} {
+		ts, err := tango.NewStochRSIWithLines(5, 5, 3, 3, mat)
+		assert.NoError(t, err)
+
+		s, err := NewStochRSIWithLines(5, 5, 3, 3, mat)
+		assert.NoError(t, err)
+
+		assert.Equal(t, ts.Count(), s.Count())
+		assert.Equal(t, ts.CountLines(), s.CountLines())
+
+		_, _, err = s.CalcLines(dd[:s.CountLines()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		_, err = s.CalcSeriesLines(dd[:s.CountLines()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		for i := s.CountLines(); i <= len(dd); i++ {
+			expK, expD, err := ts.CalcLines(tdd[i-s.CountLines() : i])
+			assert.NoError(t, err)
+
+			resK, resD, err := s.CalcLines(dd[i-s.CountLines() : i])
+			assert.NoError(t, err)
+			assertCloseTo(t, expK, resK)
+			assertCloseTo(t, expD, resD)
+		}
+
+		exp, err := ts.CalcSeriesLines(tdd)
+		assert.NoError(t, err)
+
+		res, err := s.CalcSeriesLines(dd)
+		assert.NoError(t, err)
+
+		if assert.Len(t, res, len(exp)) {
+			for i := range exp {
+				assertCloseTo(t, exp[i].K, res[i].K)
+				assertCloseTo(t, exp[i].D, res[i].D)
+			}
+		}
+
+		st, err := s.StreamLines()
+		assert.NoError(t, err)
+		assertStreamMatchesCalc(t, st, s.CountLines(), func(dd []float64) (StochRSIResult, error) {
+			k, d, err := s.CalcLines(dd)
+			return StochRSIResult{K: k, D: d}, err
+		}, dd)
+	}
+}
+
+func Test_NewStoch(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result Stoch
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new Stoch": {
+			Length: 5,
+			Result: Stoch{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewStoch(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_Stoch_CrossCheck(t *testing.T) {
+	_, err := Stoch{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = Stoch{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = Stoch{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tstoch, err := tango.NewStoch(5)
+	assert.NoError(t, err)
+
+	stoch, err := NewStoch(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, tstoch.Count(), stoch.Count())
+
+	dd := crossCheckData()
+	tdd := decimals(dd)
+
+	_, err = stoch.Calc(dd[:stoch.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = stoch.CalcSeries(dd[:stoch.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	res, err := stoch.Calc(make([]float64, stoch.Count()))
+	assert.NoError(t, err)
+	assert.Equal(t, 0.0, res)
+
+	for i := stoch.Count(); i <= len(dd); i++ {
+		exp, err := tstoch.Calc(tdd[i-stoch.Count() : i])
+		assert.NoError(t, err)
+
+		res, err := stoch.Calc(dd[i-stoch.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, exp, res)
+	}
+
+	exp, err := tstoch.CalcSeries(tdd)
+	assert.NoError(t, err)
+
+	ress, err := stoch.CalcSeries(dd)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, ress)
+
+	s, err := stoch.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, stoch.Count(), stoch.Calc, dd)
+}
+
+func Test_NewFullStoch(t *testing.T) {
+	cc := map[string]struct {
+		Length     int
+		KSmoothing int
+		DLength    int
+		Type       tango.MAType
+		Result     FullStoch
+		Error      error
+	}{
+		"Invalid length": {
+			KSmoothing: 3,
+			DLength:    3,
+			Type:       tango.MATypeSimple,
+			Error:      tango.ErrInvalidLength,
+		},
+		"Invalid %K smoothing length": {
+			Length:  14,
+			DLength: 3,
+			Type:    tango.MATypeSimple,
+			Error:   tango.ErrInvalidLength,
+		},
+		"Invalid %D length": {
+			Length:     14,
+			KSmoothing: 3,
+			Type:       tango.MATypeSimple,
+			Error:      tango.ErrInvalidLength,
+		},
+		"Invalid MA type": {
+			Length:     14,
+			KSmoothing: 3,
+			DLength:    3,
+			Error:      tango.ErrInvalidMA,
+		},
+		"Successfully created new FullStoch": {
+			Length:     14,
+			KSmoothing: 3,
+			DLength:    3,
+			Type:       tango.MATypeSimple,
+			Result: FullStoch{
+				valid: true,
+				stoch: Stoch{valid: true, length: 14},
+				k:     SMA{valid: true, length: 3},
+				d:     SMA{valid: true, length: 3},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewFullStoch(c.Length, c.KSmoothing, c.DLength, c.Type)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_FullStoch_CrossCheck(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	_, _, err := FullStoch{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = FullStoch{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = FullStoch{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	for _, mat := range []tango.MAType{tango.MATypeSimple, tango.MATypeExponential} {
+		tfs, err := tango.NewFullStoch(5, 3, 3, mat)
+		assert.NoError(t, err)
+
+		fs, err := NewFullStoch(5, 3, 3, mat)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tfs.Count(), fs.Count())
+
+		_, _, err = fs.Calc(cc[:fs.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		_, err = fs.CalcSeries(cc[:fs.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		for i := fs.Count(); i <= len(cc); i++ {
+			expK, expD, err := tfs.Calc(tcc[i-fs.Count() : i])
+			assert.NoError(t, err)
+
+			resK, resD, err := fs.Calc(cc[i-fs.Count() : i])
+			assert.NoError(t, err)
+			assertCloseTo(t, expK, resK)
+			assertCloseTo(t, expD, resD)
+		}
+
+		exp, err := tfs.CalcSeries(tcc)
+		assert.NoError(t, err)
+
+		res, err := fs.CalcSeries(cc)
+		assert.NoError(t, err)
+
+		if assert.Len(t, res, len(exp)) {
+			for i := range exp {
+				assertCloseTo(t, exp[i].K, res[i].K)
+				assertCloseTo(t, exp[i].D, res[i].D)
+			}
+		}
+
+		s, err := fs.Stream()
+		assert.NoError(t, err)
+		assertStreamMatchesCalc(t, s, fs.Count(), func(cc []Candle) (FullStochResult, error) {
+			k, d, err := fs.Calc(cc)
+			return FullStochResult{K: k, D: d}, err
+		}, cc)
+	}
+}
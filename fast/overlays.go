@@ -0,0 +1,2589 @@
+package fast
+
+import (
+	"math"
+	"time"
+
+	"github.com/jellydator/tango"
+)
+
+// ALMA holds all the necessary information needed to calculate Arnaud
+// Legoux moving average.
+// The zero value is not usable.
+type ALMA struct {
+	// valid specifies whether ALMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// offset specifies the position of the gaussian curve peak within
+	// the window, from 0 (the oldest data point) to 1 (the newest one).
+	offset float64
+
+	// sigma specifies the sharpness of the gaussian curve.
+	sigma float64
+}
+
+// NewALMA validates provided configuration options and creates new ALMA
+// indicator. The default offset of 0.85 and sigma of 6 are used.
+func NewALMA(length int) (ALMA, error) {
+	return NewALMAWithOptions(length, 0.85, 6)
+}
+
+// NewALMAWithOptions validates provided configuration options and creates
+// new ALMA indicator with the provided offset, which must be between 0 and
+// 1, and a positive sigma.
+func NewALMAWithOptions(length int, offset, sigma float64) (ALMA, error) {
+	alma := ALMA{
+		length: length,
+		offset: offset,
+		sigma:  sigma,
+	}
+
+	if err := alma.validate(); err != nil {
+		return ALMA{}, err
+	}
+
+	return alma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (alma *ALMA) validate() error {
+	if alma.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	if alma.offset < 0 || alma.offset > 1 {
+		return tango.ErrInvalidOffset
+	}
+
+	if alma.sigma <= 0 {
+		return tango.ErrInvalidSigma
+	}
+
+	alma.valid = true
+
+	return nil
+}
+
+// Calc calculates ALMA from the provided data points slice.
+func (alma ALMA) Calc(dd []float64) (float64, error) {
+	if !alma.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != alma.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	return alma.calc(dd, alma.weights()), nil
+}
+
+// calc calculates ALMA from the provided data points slice by using
+// precalculated weights.
+func (alma ALMA) calc(dd, ww []float64) float64 {
+	var res, weight float64
+
+	for i := range dd {
+		res += dd[i] * ww[i]
+		weight += ww[i]
+	}
+
+	return res / weight
+}
+
+// weights calculates gaussian weights of every data point of the window.
+func (alma ALMA) weights() []float64 {
+	m := alma.offset * float64(alma.length-1)
+	s := float64(alma.length) / alma.sigma
+
+	res := make([]float64, alma.length)
+
+	for i := range res {
+		res[i] = math.Exp(-math.Pow(float64(i)-m, 2) / (2 * s * s))
+	}
+
+	return res
+}
+
+// Count determines the total amount of data points needed for ALMA
+// calculation.
+func (alma ALMA) Count() int {
+	return alma.length
+}
+
+// CalcSeries calculates ALMA for every window of Count() data points of
+// the provided slice.
+func (alma ALMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !alma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < alma.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	ww := alma.weights()
+
+	return calcWindows(alma.Count(), dd, func(dd []float64) (float64, error) {
+		return alma.calc(dd, ww), nil
+	})
+}
+
+// Stream creates new ALMA stream that calculates ALMA from the most recent
+// data points each time a new data point is added.
+func (alma ALMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !alma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(alma.Count(), alma.Calc)
+}
+
+// BBResult holds all values produced by a single BB calculation.
+type BBResult struct {
+	// Upper is the upper band value.
+	Upper float64
+
+	// Middle is the middle band (moving average) value.
+	Middle float64
+
+	// Lower is the lower band value.
+	Lower float64
+
+	// Width is the band width value.
+	Width float64
+
+	// PercentB is the position of the latest data point within
+	// the bands.
+	PercentB float64
+}
+
+// BB holds all the necessary information needed to calculate Bollinger Bands.
+// The zero value is not usable.
+type BB struct {
+	// valid specifies whether BB paremeters were validated.
+	valid bool
+
+	// stdDev specifies how to adjust the deviation.
+	stdDev float64
+
+	// ma specifies MA indicator configuration.
+	ma MA
+
+	// dev specifies which deviation measure should be used. Population
+	// standard deviation is used when it is zero.
+	dev tango.Deviation
+
+	// atr specifies ATR indicator configuration, it is used only
+	// with tango.DeviationATR.
+	atr ATR
+}
+
+// NewBB validates provided configuration options and creates
+// new BB indicator. Population standard deviation is used.
+func NewBB(mat tango.MAType, stdDev float64, length int) (BB, error) {
+	return newBB(mat, 0, stdDev, length)
+}
+
+// NewBBWithDeviation validates provided configuration options and creates
+// new BB indicator that uses the provided deviation measure.
+// tango.DeviationATR requires candles, so only the candle methods, i.e.
+// CalcCandles, CalcBandCandles and CalcSeriesCandles, can be used with
+// it; ATR is smoothed with the same moving average type as the middle
+// band.
+func NewBBWithDeviation(mat tango.MAType, dev tango.Deviation, stdDev float64, length int) (BB, error) {
+	if err := dev.Validate(); err != nil {
+		return BB{}, err
+	}
+
+	return newBB(mat, dev, stdDev, length)
+}
+
+// newBB creates new BB indicator without validating the deviation
+// measure.
+func newBB(mat tango.MAType, dev tango.Deviation, stdDev float64, length int) (BB, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return BB{}, err
+	}
+
+	bb := BB{
+		stdDev: stdDev,
+		ma:     ma,
+		dev:    dev,
+	}
+
+	if dev == tango.DeviationATR {
+		bb.atr, err = NewATR(length, mat)
+		if err != nil {
+			// unlikely to happen
+			return BB{}, err
+		}
+	}
+
+	if err := bb.validate(); err != nil {
+		return BB{}, err
+	}
+
+	return bb, nil
+}
+
+func (bb *BB) validate() error {
+	if bb.stdDev <= 0 {
+		return tango.ErrInvalidStandardDeviation
+	}
+
+	bb.valid = true
+
+	return nil
+}
+
+// Calc calculates all BB values from provided data points slice.
+func (bb BB) Calc(dd []float64) (upper, lower, width float64, err error) {
+	res, sdev, err := bb.calc(dd)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return bb.calcUpper(res, sdev), bb.calcLower(res, sdev), bb.calcWidth(res, sdev), nil
+}
+
+// CalcBand calculates specified BB value from provided data points slice.
+func (bb BB) CalcBand(dd []float64, band tango.Band) (float64, error) {
+	if err := band.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, sdev, err := bb.calc(dd)
+	if err != nil {
+		return 0, err
+	}
+
+	return bb.calcBand(band, dd[len(dd)-1], res, sdev), nil
+}
+
+// CalcCandles calculates all BB values from the provided candles slice.
+// Closing prices are used for the middle band and the deviation, unless
+// tango.DeviationATR is used.
+func (bb BB) CalcCandles(cc []Candle) (upper, lower, width float64, err error) {
+	res, sdev, err := bb.calcCandles(cc)
+	if err != nil {
+		return 0, 0, 0, err
+	}
+
+	return bb.calcUpper(res, sdev), bb.calcLower(res, sdev), bb.calcWidth(res, sdev), nil
+}
+
+// CalcBandCandles calculates specified BB value from the provided candles
+// slice. Closing prices are used for the middle band and the deviation,
+// unless tango.DeviationATR is used.
+func (bb BB) CalcBandCandles(cc []Candle, band tango.Band) (float64, error) {
+	if err := band.Validate(); err != nil {
+		return 0, err
+	}
+
+	res, sdev, err := bb.calcCandles(cc)
+	if err != nil {
+		return 0, err
+	}
+
+	return bb.calcBand(band, cc[len(cc)-1].Close, res, sdev), nil
+}
+
+// calcBand calculates specified BB value from the last data point, the
+// moving average value and the adjusted deviation.
+func (bb BB) calcBand(band tango.Band, last, res, sdev float64) float64 {
+	switch band {
+	case tango.BandUpper:
+		return bb.calcUpper(res, sdev)
+	case tango.BandMiddle:
+		return res
+	case tango.BandLower:
+		return bb.calcLower(res, sdev)
+	case tango.BandPercentB:
+		return bb.calcPercentB(last, res, sdev)
+	default: // band is validated, only tango.BandWidth is left.
+		return bb.calcWidth(res, sdev)
+	}
+}
+
+func (bb BB) calc(dd []float64) (ma, sdev float64, err error) {
+	if !bb.valid {
+		return 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if bb.dev == tango.DeviationATR {
+		return 0, 0, tango.ErrInvalidDeviation
+	}
+
+	if len(dd) != bb.Count() {
+		return 0, 0, tango.ErrInvalidDataSize
+	}
+
+	ma, err = bb.ma.Calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, err
+	}
+
+	return ma, bb.deviation(dd) * bb.stdDev, nil
+}
+
+func (bb BB) calcCandles(cc []Candle) (ma, sdev float64, err error) {
+	if !bb.valid {
+		return 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != bb.Count() {
+		return 0, 0, tango.ErrInvalidDataSize
+	}
+
+	dd := make([]float64, bb.ma.Count())
+
+	for i := range dd {
+		dd[i] = cc[len(cc)-len(dd)+i].Close
+	}
+
+	if bb.dev != tango.DeviationATR {
+		return bb.calc(dd)
+	}
+
+	ma, err = bb.ma.Calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, err
+	}
+
+	atr, err := bb.atr.Calc(cc)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, err
+	}
+
+	return ma, atr * bb.stdDev, nil
+}
+
+// deviation calculates the configured deviation measure of the provided
+// data points.
+func (bb BB) deviation(dd []float64) float64 {
+	switch bb.dev {
+	case tango.DeviationSample:
+		return SampleStandardDeviation(dd)
+	case tango.DeviationMean:
+		return MeanDeviation(dd)
+	default:
+		return StandardDeviation(dd)
+	}
+}
+
+func (bb BB) calcUpper(res, sdev float64) float64 {
+	return res + sdev
+}
+
+func (bb BB) calcLower(res, sdev float64) float64 {
+	return res - sdev
+}
+
+func (bb BB) calcWidth(res, sdev float64) float64 {
+	return (res + sdev - (res - sdev)) / res * 100
+}
+
+// result calculates all BB values from the last data point, the moving
+// average value and the adjusted standard deviation.
+func (bb BB) result(last, res, sdev float64) BBResult {
+	return BBResult{
+		Upper:    bb.calcUpper(res, sdev),
+		Middle:   res,
+		Lower:    bb.calcLower(res, sdev),
+		Width:    bb.calcWidth(res, sdev),
+		PercentB: bb.calcPercentB(last, res, sdev),
+	}
+}
+
+// calcPercentB calculates %B, which is 0 when the last data point is at
+// the lower band and 1 when it is at the upper band.
+func (bb BB) calcPercentB(last, res, sdev float64) float64 {
+	dnm := bb.calcUpper(res, sdev) - bb.calcLower(res, sdev)
+	if dnm == 0 {
+		return 0
+	}
+
+	return (last - bb.calcLower(res, sdev)) / dnm
+}
+
+// Count determines the total amount of data points needed for BB
+// calculation. tango.DeviationATR requires an additional candle for the
+// true range calculation.
+func (bb BB) Count() int {
+	if bb.dev == tango.DeviationATR {
+		return bb.atr.Count()
+	}
+
+	return bb.ma.Count()
+}
+
+// CalcSeries calculates all BB values for every window of Count() data
+// points of the provided slice.
+func (bb BB) CalcSeries(dd []float64) ([]BBResult, error) {
+	if !bb.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if bb.dev == tango.DeviationATR {
+		return nil, tango.ErrInvalidDeviation
+	}
+
+	if len(dd) < bb.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	mas, err := CalcMASeries(bb.ma, dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	sdevs, err := calcWindows(bb.Count(), dd, func(dd []float64) (float64, error) {
+		return bb.deviation(dd), nil
+	})
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	res := make([]BBResult, len(mas))
+
+	for i := range res {
+		res[i] = bb.result(dd[i+bb.Count()-1], mas[i], sdevs[i]*bb.stdDev)
+	}
+
+	return res, nil
+}
+
+// CalcSeriesCandles calculates all BB values for every window of Count()
+// candles of the provided slice. Closing prices are used for the middle
+// band and the deviation, unless tango.DeviationATR is used. The values are
+// identical to the ones CalcCandles produces.
+func (bb BB) CalcSeriesCandles(cc []Candle) ([]BBResult, error) {
+	if !bb.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < bb.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	dd := make([]float64, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].Close
+	}
+
+	if bb.dev != tango.DeviationATR {
+		return bb.CalcSeries(dd)
+	}
+
+	// the first candle of every window is used only for the true range.
+	dd = dd[bb.Count()-bb.ma.Count():]
+
+	mas, err := CalcMASeries(bb.ma, dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	atrs, err := bb.atr.CalcSeries(cc)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	res := make([]BBResult, len(mas))
+
+	for i := range res {
+		res[i] = bb.result(dd[i+bb.ma.Count()-1], mas[i], atrs[i]*bb.stdDev)
+	}
+
+	return res, nil
+}
+
+// Stream creates new BB stream that calculates all BB values from the
+// most recent data points each time a new data point is added.
+func (bb BB) Stream() (*tango.Stream[float64, BBResult], error) {
+	if !bb.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if bb.dev == tango.DeviationATR {
+		return nil, tango.ErrInvalidDeviation
+	}
+
+	return tango.NewStream(bb.Count(), func(dd []float64) (BBResult, error) {
+		res, sdev, err := bb.calc(dd)
+		if err != nil {
+			// unlikely to happen
+			return BBResult{}, err
+		}
+
+		return bb.result(dd[len(dd)-1], res, sdev), nil
+	})
+}
+
+// DCResult holds all values produced by a single DC calculation.
+type DCResult struct {
+	// Upper is the upper channel value.
+	Upper float64
+
+	// Middle is the middle channel value.
+	Middle float64
+
+	// Lower is the lower channel value.
+	Lower float64
+}
+
+// DC holds all the necessary information needed to calculate Donchian
+// Channels.
+// The zero value is not usable.
+type DC struct {
+	// valid specifies whether DC paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewDC validates provided configuration options and creates
+// new DC indicator.
+func NewDC(length int) (DC, error) {
+	dc := DC{
+		length: length,
+	}
+
+	if err := dc.validate(); err != nil {
+		return DC{}, err
+	}
+
+	return dc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (dc *DC) validate() error {
+	if dc.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	dc.valid = true
+
+	return nil
+}
+
+// Calc calculates all DC values from the provided candles slice.
+func (dc DC) Calc(cc []Candle) (upper, middle, lower float64, err error) {
+	if !dc.valid {
+		return 0, 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != dc.Count() {
+		return 0, 0, 0, tango.ErrInvalidDataSize
+	}
+
+	upper = cc[0].High
+	lower = cc[0].Low
+
+	for i := 1; i < len(cc); i++ {
+		upper = math.Max(upper, cc[i].High)
+		lower = math.Min(lower, cc[i].Low)
+	}
+
+	return upper, dc.calcMiddle(upper, lower), lower, nil
+}
+
+// CalcBand calculates specified DC value from the provided candles slice.
+// Only tango.BandUpper, tango.BandMiddle and tango.BandLower are
+// supported.
+func (dc DC) CalcBand(cc []Candle, band tango.Band) (float64, error) {
+	if band != tango.BandUpper && band != tango.BandMiddle && band != tango.BandLower {
+		return 0, tango.ErrInvalidBand
+	}
+
+	upper, middle, lower, err := dc.Calc(cc)
+	if err != nil {
+		return 0, err
+	}
+
+	switch band {
+	case tango.BandUpper:
+		return upper, nil
+	case tango.BandMiddle:
+		return middle, nil
+	default: // band is validated, only BandLower is left.
+		return lower, nil
+	}
+}
+
+func (dc DC) calcMiddle(upper, lower float64) float64 {
+	return (upper + lower) / 2
+}
+
+// Count determines the total amount of candles needed for DC
+// calculation.
+func (dc DC) Count() int {
+	return dc.length
+}
+
+// CalcSeries calculates all DC values for every window of Count() candles
+// of the provided slice. Monotonic deques are used, so the calculation
+// takes linear time.
+func (dc DC) CalcSeries(cc []Candle) ([]DCResult, error) {
+	if !dc.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < dc.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	lows := make([]float64, len(cc))
+	highs := make([]float64, len(cc))
+
+	for i := range cc {
+		lows[i] = cc[i].Low
+		highs[i] = cc[i].High
+	}
+
+	lows, _ = rollingExtremes(lows, dc.length)
+	_, highs = rollingExtremes(highs, dc.length)
+
+	res := make([]DCResult, len(lows))
+
+	for i := range res {
+		res[i] = DCResult{
+			Upper:  highs[i],
+			Middle: dc.calcMiddle(highs[i], lows[i]),
+			Lower:  lows[i],
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new DC stream that calculates all DC values from the
+// most recent candles each time a new candle is added.
+func (dc DC) Stream() (*tango.Stream[Candle, DCResult], error) {
+	if !dc.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(dc.Count(), func(cc []Candle) (DCResult, error) {
+		upper, middle, lower, err := dc.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return DCResult{}, err
+		}
+
+		return DCResult{
+			Upper:  upper,
+			Middle: middle,
+			Lower:  lower,
+		}, nil
+	})
+}
+
+// DEMA holds all the necessary information needed to calculate
+// double exponential moving average.
+// The zero value is not usable.
+type DEMA struct {
+	// valid specifies whether DEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for dema calculations.
+	ema EMA
+}
+
+// NewDEMA validates provided configuration options and
+// creates new DEMA indicator.
+func NewDEMA(length int) (DEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return DEMA{}, err
+	}
+
+	return DEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates DEMA from the provided data points slice.
+func (dema DEMA) Calc(dd []float64) (float64, error) {
+	if !dema.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != dema.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	ee := dema.ema.chain(dd, 2)
+
+	return ee[1][len(ee[1])-1], nil
+}
+
+// Count determines the total amount of data points needed for DEMA
+// calculation.
+func (dema DEMA) Count() int {
+	return dema.ema.Count()
+}
+
+// CalcSeries calculates DEMA for every window of Count() data points of
+// the provided slice. Both EMAs are recalculated for every window, so the
+// values are identical to the ones Calc produces.
+func (dema DEMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !dema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(dema.Count(), dd, dema.Calc)
+}
+
+// CalcContinuousSeries calculates DEMA over the whole provided slice.
+// Both EMAs are seeded once and then smoothed up to the last data point,
+// so only the first value matches Calc.
+func (dema DEMA) CalcContinuousSeries(dd []float64) ([]float64, error) {
+	if !dema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < dema.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	ee := dema.ema.chain(dd, 2)
+
+	return ee[1][dema.Count()-dema.ema.sma.length:], nil
+}
+
+// Stream creates new DEMA stream that calculates DEMA from the most recent
+// data points each time a new data point is added.
+func (dema DEMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !dema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(dema.Count(), dema.Calc)
+}
+
+// EMA holds all the necessary information needed to calculate exponential
+// moving average.
+// The zero value is not usable.
+type EMA struct {
+	// valid specifies whether EMA paremeters were validated.
+	valid bool
+
+	// sma specifies what sma should be used for ema calculations.
+	sma SMA
+
+	// seed specifies how the first EMA value should be determined.
+	// SMA seed is used when it is not set.
+	seed tango.EMASeed
+
+	// value specifies EMA value preceding the first data point.
+	value float64
+
+	// alpha specifies the smoothing factor. 2/(length+1) is used when it
+	// is zero.
+	alpha float64
+}
+
+// NewEMA validates provided configuration options and
+// creates new EMA indicator.
+func NewEMA(length int) (EMA, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return EMA{}, err
+	}
+
+	return EMA{
+		valid: true,
+		sma:   sma,
+	}, nil
+}
+
+// NewEMAWithOptions validates provided configuration options and creates
+// new EMA indicator that is seeded and smoothed as specified by the
+// options.
+func NewEMAWithOptions(length int, opts tango.EMAOptions) (EMA, error) {
+	if err := opts.Validate(); err != nil {
+		return EMA{}, err
+	}
+
+	ema, err := NewEMA(length)
+	if err != nil {
+		return EMA{}, err
+	}
+
+	ema.seed = opts.Seed
+	ema.alpha = opts.Alpha.InexactFloat64()
+
+	if opts.Seed == tango.EMASeedValue {
+		ema.value = opts.Value.InexactFloat64()
+	}
+
+	return ema, nil
+}
+
+// Calc calculates EMA from the provided data points slice.
+func (ema EMA) Calc(dd []float64) (float64, error) {
+	if !ema.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != ema.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	res, next := ema.start(dd)
+
+	for i := next; i < len(dd); i++ {
+		res = ema.next(res, dd[i])
+	}
+
+	return res, nil
+}
+
+// start determines the first EMA value and the index of the data point
+// the smoothing should be continued from.
+func (ema EMA) start(dd []float64) (float64, int) {
+	switch ema.seed {
+	case tango.EMASeedFirst:
+		return dd[0], 1
+	case tango.EMASeedValue:
+		return ema.value, 0
+	default:
+		return ema.sma.calc(dd[:ema.sma.length]), ema.sma.length
+	}
+}
+
+// CalcNext calculates sequential EMA by using previous EMA.
+func (ema EMA) CalcNext(lres, d float64) (float64, error) {
+	if !ema.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	return ema.next(lres, d), nil
+}
+
+// next calculates sequential EMA without validating the indicator.
+func (ema EMA) next(lres, d float64) float64 {
+	mtp := ema.multiplier()
+
+	return d*mtp + lres*(1-mtp)
+}
+
+// multiplier calculates EMA multiplier.
+func (ema EMA) multiplier() float64 {
+	if ema.alpha != 0 {
+		return ema.alpha
+	}
+
+	return 2 / float64(ema.sma.length+1)
+}
+
+// Count determines the total amount of data points needed for EMA
+// calculation. SMA seeded EMA requires length data points to seed the
+// average, while other seeds require only length data points in total.
+func (ema EMA) Count() int {
+	switch ema.seed {
+	case tango.EMASeedFirst, tango.EMASeedValue:
+		return ema.sma.length
+	default:
+		return ema.sma.length*2 - 1
+	}
+}
+
+// CalcSeries calculates EMA for every window of Count() data points of
+// the provided slice. Every window is seeded separately, so the values are
+// identical to the ones Calc produces and the calculation takes
+// O(n*Count()) time.
+func (ema EMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !ema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(ema.Count(), dd, ema.Calc)
+}
+
+// CalcContinuousSeries calculates EMA over the whole provided slice
+// without reseeding. The first value matches Calc, every following data
+// point is added with CalcNext, as charting platforms do, so the
+// calculation takes linear time.
+func (ema EMA) CalcContinuousSeries(dd []float64) ([]float64, error) {
+	if !ema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < ema.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res := make([]float64, len(dd)-ema.Count()+1)
+
+	curr, next := ema.start(dd)
+
+	for i := next - 1; i < len(dd); i++ {
+		if i >= next {
+			curr = ema.next(curr, dd[i])
+		}
+
+		if i >= ema.Count()-1 {
+			res[i-ema.Count()+1] = curr
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new EMA stream that calculates EMA from the most recent
+// data points each time a new data point is added. EMA seeded with
+// tango.EMASeedValue can't be streamed, as every window would be seeded
+// with the same value, CalcNext should be used instead.
+func (ema EMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !ema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if ema.seed == tango.EMASeedValue {
+		return nil, tango.ErrInvalidEMASeed
+	}
+
+	return tango.NewStream(ema.Count(), ema.Calc)
+}
+
+// chain calculates EMAs of the provided data points, EMAs of those EMAs
+// and so on, until depth EMA series are calculated. The first series is
+// seeded as configured, while every other series is seeded with the same
+// value as the first one, i.e. with the first value of the series it
+// smooths. Every series starts at the length-th data point.
+func (ema EMA) chain(dd []float64, depth int) [][]float64 {
+	res := make([][]float64, depth)
+
+	for i := range res {
+		res[i] = make([]float64, len(dd)-ema.sma.length+1)
+	}
+
+	seed, next := ema.start(dd)
+	curr := make([]float64, depth)
+
+	for i := range curr {
+		curr[i] = seed
+	}
+
+	for i := next - 1; i < len(dd); i++ {
+		if i >= next {
+			d := dd[i]
+
+			for j := range curr {
+				curr[j] = ema.next(curr[j], d)
+				d = curr[j]
+			}
+		}
+
+		if i >= ema.sma.length-1 {
+			for j := range curr {
+				res[j][i-ema.sma.length+1] = curr[j]
+			}
+		}
+	}
+
+	return res
+}
+
+// HMA holds all the necessary information needed to calculate
+// hull moving average.
+// The zero value is not usable.
+type HMA struct {
+	// valid specifies whether HMA paremeters were validated.
+	valid bool
+
+	// wma specifies the base moving average.
+	wma WMA
+}
+
+// NewHMA validates provided configuration options and
+// creates new HMA indicator.
+func NewHMA(length int) (HMA, error) {
+	wma, err := NewWMA(length)
+	if err != nil {
+		return HMA{}, err
+	}
+
+	return HMA{
+		valid: true,
+		wma:   wma,
+	}, nil
+}
+
+// Calc calculates HMA from the provided data points slice.
+func (h HMA) Calc(dd []float64) (float64, error) {
+	if !h.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != h.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	wma1 := WMA{length: h.wma.length / 2, valid: true}
+	wma2 := WMA{length: int(math.Sqrt(float64(h.wma.length))), valid: true}
+
+	res := make([]float64, wma2.length)
+
+	for i := range res {
+		res[i] = wma1.calc(dd[i:wma1.length+i])*2 - h.wma.calc(dd[i:h.wma.length+i])
+	}
+
+	return wma2.calc(res), nil
+}
+
+// Count determines the total amount of data points needed for HMA
+// calculation.
+func (h HMA) Count() int {
+	return int(math.Sqrt(float64(h.wma.length))) + h.wma.length - 1
+}
+
+// CalcSeries calculates HMA for every window of Count() data points of
+// the provided slice. Weighted moving averages are rolled over the
+// series, so the calculation takes linear time.
+func (h HMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !h.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < h.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	wma1 := WMA{length: h.wma.length / 2, valid: true}
+	wma2 := WMA{length: int(math.Sqrt(float64(h.wma.length))), valid: true}
+
+	res1 := wma1.series(dd)
+	res2 := h.wma.series(dd)
+
+	res := make([]float64, len(res2))
+
+	for i := range res {
+		res[i] = res1[i]*2 - res2[i]
+	}
+
+	return wma2.series(res), nil
+}
+
+// Stream creates new HMA stream that calculates HMA from the most recent
+// data points each time a new data point is added.
+func (h HMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !h.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(h.Count(), h.Calc)
+}
+
+// KAMA holds all the necessary information needed to calculate Kaufman's
+// adaptive moving average.
+// The zero value is not usable.
+type KAMA struct {
+	// valid specifies whether KAMA paremeters were validated.
+	valid bool
+
+	// sma specifies what sma should be used to seed kama calculations.
+	sma SMA
+
+	// fast specifies the length of the fastest EMA the smoothing
+	// constant may reach.
+	fast int
+
+	// slow specifies the length of the slowest EMA the smoothing
+	// constant may reach.
+	slow int
+}
+
+// NewKAMA validates provided configuration options and creates new KAMA
+// indicator. The efficiency ratio is calculated over length data points,
+// while the default fastest and slowest EMA lengths of 2 and 30 are used.
+func NewKAMA(length int) (KAMA, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return KAMA{}, err
+	}
+
+	return KAMA{
+		valid: true,
+		sma:   sma,
+		fast:  2,
+		slow:  30,
+	}, nil
+}
+
+// Calc calculates KAMA from the provided data points slice. The first
+// length data points are used to seed the average with SMA.
+func (kama KAMA) Calc(dd []float64) (float64, error) {
+	if !kama.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != kama.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	res := kama.series(dd)
+
+	return res[len(res)-1], nil
+}
+
+// series calculates KAMA of every data point of the provided slice,
+// starting at the length-th one.
+func (kama KAMA) series(dd []float64) []float64 {
+	length := kama.sma.length
+	res := make([]float64, len(dd)-length+1)
+	res[0] = kama.sma.calc(dd[:length])
+
+	fast := 2 / float64(kama.fast+1)
+	slow := 2 / float64(kama.slow+1)
+
+	// volatility holds the sum of absolute changes of the current window.
+	var volatility float64
+
+	for i := 1; i < length; i++ {
+		volatility += math.Abs(dd[i] - dd[i-1])
+	}
+
+	for i := length; i < len(dd); i++ {
+		volatility += math.Abs(dd[i] - dd[i-1])
+
+		if i > length {
+			volatility -= math.Abs(dd[i-length] - dd[i-length-1])
+		}
+
+		var er float64
+		if volatility != 0 {
+			er = math.Abs(dd[i]-dd[i-length]) / volatility
+		}
+
+		sc := er*(fast-slow) + slow
+		prev := res[i-length]
+
+		res[i-length+1] = prev + sc*sc*(dd[i]-prev)
+	}
+
+	return res
+}
+
+// Count determines the total amount of data points needed for KAMA
+// calculation.
+func (kama KAMA) Count() int {
+	return kama.sma.length*2 - 1
+}
+
+// CalcSeries calculates KAMA for every window of Count() data points of
+// the provided slice. Every window is seeded with its own SMA, so the
+// values are identical to the ones Calc produces.
+func (kama KAMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !kama.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(kama.Count(), dd, kama.Calc)
+}
+
+// CalcContinuousSeries calculates KAMA over the whole provided slice,
+// adapting a single average seeded from the first data points. Only the
+// first value matches Calc.
+func (kama KAMA) CalcContinuousSeries(dd []float64) ([]float64, error) {
+	if !kama.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < kama.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	return kama.series(dd)[kama.Count()-kama.sma.length:], nil
+}
+
+// Stream creates new KAMA stream that calculates KAMA from the most recent
+// data points each time a new data point is added.
+func (kama KAMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !kama.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(kama.Count(), kama.Calc)
+}
+
+// KCResult holds all values produced by a single KC calculation.
+type KCResult struct {
+	// Upper is the upper channel value.
+	Upper float64
+
+	// Middle is the middle channel value.
+	Middle float64
+
+	// Lower is the lower channel value.
+	Lower float64
+}
+
+// KC holds all the necessary information needed to calculate Keltner
+// Channels.
+// The zero value is not usable.
+type KC struct {
+	// valid specifies whether KC paremeters were validated.
+	valid bool
+
+	// multiplier specifies how to adjust ATR.
+	multiplier float64
+
+	// ma specifies MA indicator configuration used for the middle line.
+	ma MA
+
+	// atr specifies ATR indicator configuration.
+	atr ATR
+}
+
+// NewKC validates provided configuration options and creates
+// new KC indicator. Wilder smoothed ATR is used.
+func NewKC(mat tango.MAType, length, atrLength int, multiplier float64) (KC, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return KC{}, err
+	}
+
+	atr, err := NewATR(atrLength, tango.MATypeSmoothed)
+	if err != nil {
+		return KC{}, err
+	}
+
+	kc := KC{
+		multiplier: multiplier,
+		ma:         ma,
+		atr:        atr,
+	}
+
+	if err := kc.validate(); err != nil {
+		return KC{}, err
+	}
+
+	return kc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (kc *KC) validate() error {
+	if kc.multiplier <= 0 {
+		return tango.ErrInvalidFactor
+	}
+
+	kc.valid = true
+
+	return nil
+}
+
+// Calc calculates all KC values from the provided candles slice. Closing
+// prices are used for the middle line.
+func (kc KC) Calc(cc []Candle) (upper, middle, lower float64, err error) {
+	if !kc.valid {
+		return 0, 0, 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != kc.Count() {
+		return 0, 0, 0, tango.ErrInvalidDataSize
+	}
+
+	dd := make([]float64, kc.ma.Count())
+
+	for i := range dd {
+		dd[i] = cc[len(cc)-len(dd)+i].Close
+	}
+
+	middle, err = kc.ma.Calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, 0, err
+	}
+
+	atr, err := kc.atr.Calc(cc[len(cc)-kc.atr.Count():])
+	if err != nil {
+		// unlikely to happen
+		return 0, 0, 0, err
+	}
+
+	res := kc.calc(middle, atr)
+
+	return res.Upper, res.Middle, res.Lower, nil
+}
+
+// CalcBand calculates specified KC value from the provided candles slice.
+// Only tango.BandUpper, tango.BandMiddle and tango.BandLower are
+// supported.
+func (kc KC) CalcBand(cc []Candle, band tango.Band) (float64, error) {
+	if band != tango.BandUpper && band != tango.BandMiddle && band != tango.BandLower {
+		return 0, tango.ErrInvalidBand
+	}
+
+	upper, middle, lower, err := kc.Calc(cc)
+	if err != nil {
+		return 0, err
+	}
+
+	switch band {
+	case tango.BandUpper:
+		return upper, nil
+	case tango.BandMiddle:
+		return middle, nil
+	default: // band is validated, only BandLower is left.
+		return lower, nil
+	}
+}
+
+func (kc KC) calc(middle, atr float64) KCResult {
+	offset := atr * kc.multiplier
+
+	return KCResult{
+		Upper:  middle + offset,
+		Middle: middle,
+		Lower:  middle - offset,
+	}
+}
+
+// Count determines the total amount of candles needed for KC
+// calculation.
+func (kc KC) Count() int {
+	if kc.ma.Count() > kc.atr.Count() {
+		return kc.ma.Count()
+	}
+
+	return kc.atr.Count()
+}
+
+// CalcSeries calculates all KC values for every window of Count() candles
+// of the provided slice.
+func (kc KC) CalcSeries(cc []Candle) ([]KCResult, error) {
+	if !kc.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < kc.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	dd := make([]float64, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].Close
+	}
+
+	mas, err := CalcMASeries(kc.ma, dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	atrs, err := kc.atr.CalcSeries(cc)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	res := make([]KCResult, len(cc)-kc.Count()+1)
+
+	for i := range res {
+		res[i] = kc.calc(mas[len(mas)-len(res)+i], atrs[len(atrs)-len(res)+i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new KC stream that calculates all KC values from the
+// most recent candles each time a new candle is added.
+func (kc KC) Stream() (*tango.Stream[Candle, KCResult], error) {
+	if !kc.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(kc.Count(), func(cc []Candle) (KCResult, error) {
+		upper, middle, lower, err := kc.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return KCResult{}, err
+		}
+
+		return KCResult{
+			Upper:  upper,
+			Middle: middle,
+			Lower:  lower,
+		}, nil
+	})
+}
+
+// SMA holds all the necessary information needed to calculate simple
+// moving average.
+// The zero value is not usable.
+type SMA struct {
+	// valid specifies whether SMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewSMA validates provided configuration options and
+// creates new SMA indicator.
+func NewSMA(length int) (SMA, error) {
+	sma := SMA{
+		length: length,
+	}
+
+	if err := sma.validate(); err != nil {
+		return SMA{}, err
+	}
+
+	return sma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (sma *SMA) validate() error {
+	if sma.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	sma.valid = true
+
+	return nil
+}
+
+// Calc calculates SMA from the provided data points slice.
+func (sma SMA) Calc(dd []float64) (float64, error) {
+	if !sma.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != sma.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	return sma.calc(dd), nil
+}
+
+// calc calculates SMA without validating the indicator and the size of
+// the provided data points slice.
+func (sma SMA) calc(dd []float64) float64 {
+	var res float64
+
+	for i := range dd {
+		res += dd[i]
+	}
+
+	return res / float64(sma.length)
+}
+
+// Count determines the total amount of data points needed for SMA
+// calculation.
+func (sma SMA) Count() int {
+	return sma.length
+}
+
+// CalcSeries calculates SMA for every window of Count() data points of
+// the provided slice. Rolling sum is used, so the calculation takes
+// linear time.
+func (sma SMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !sma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < sma.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res := make([]float64, len(dd)-sma.Count()+1)
+	length := float64(sma.length)
+
+	var sum float64
+
+	for i := range dd {
+		sum += dd[i]
+
+		if i >= sma.length {
+			sum -= dd[i-sma.length]
+		}
+
+		if i >= sma.length-1 {
+			res[i-sma.length+1] = sum / length
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new SMA stream that calculates SMA from the most recent
+// data points each time a new data point is added.
+func (sma SMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !sma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(sma.Count(), sma.Calc)
+}
+
+// SMMA holds all the necessary information needed to calculate smoothed
+// moving average, also known as running moving average (RMA) or Wilder's
+// moving average.
+// The zero value is not usable.
+type SMMA struct {
+	// valid specifies whether SMMA paremeters were validated.
+	valid bool
+
+	// sma specifies what sma should be used for smma calculations.
+	sma SMA
+}
+
+// NewSMMA validates provided configuration options and
+// creates new SMMA indicator.
+func NewSMMA(length int) (SMMA, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return SMMA{}, err
+	}
+
+	return SMMA{
+		valid: true,
+		sma:   sma,
+	}, nil
+}
+
+// Calc calculates SMMA from the provided data points slice. The first
+// length data points are used to seed the average with SMA.
+func (smma SMMA) Calc(dd []float64) (float64, error) {
+	if !smma.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != smma.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	return smma.calc(dd), nil
+}
+
+// calc calculates SMMA without validating the indicator and the size of
+// the provided data points slice.
+func (smma SMMA) calc(dd []float64) float64 {
+	res := smma.sma.calc(dd[:smma.sma.length])
+
+	for i := smma.sma.length; i < len(dd); i++ {
+		res = smma.next(res, dd[i])
+	}
+
+	return res
+}
+
+// CalcNext calculates sequential SMMA by using previous SMMA.
+func (smma SMMA) CalcNext(lres, d float64) (float64, error) {
+	if !smma.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	return smma.next(lres, d), nil
+}
+
+// next calculates sequential SMMA without validating the indicator.
+func (smma SMMA) next(lres, d float64) float64 {
+	length := float64(smma.sma.length)
+
+	return (lres*(length-1) + d) / length
+}
+
+// Count determines the total amount of data points needed for SMMA
+// calculation.
+func (smma SMMA) Count() int {
+	return smma.sma.length*2 - 1
+}
+
+// CalcSeries calculates SMMA for every window of Count() data points of
+// the provided slice. The values are identical to the ones Calc produces,
+// since every window is seeded with its own SMA.
+func (smma SMMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !smma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(smma.Count(), dd, smma.Calc)
+}
+
+// CalcContinuousSeries calculates SMMA over the whole provided slice,
+// seeding it only once with the first Count() data points and adding the
+// rest with CalcNext. It returns as many values as CalcSeries, but only
+// the first one matches Calc.
+func (smma SMMA) CalcContinuousSeries(dd []float64) ([]float64, error) {
+	if !smma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < smma.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res := make([]float64, len(dd)-smma.Count()+1)
+	res[0] = smma.calc(dd[:smma.Count()])
+
+	for i := smma.Count(); i < len(dd); i++ {
+		res[i-smma.Count()+1] = smma.next(res[i-smma.Count()], dd[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new SMMA stream that calculates SMMA from the most recent
+// data points each time a new data point is added.
+func (smma SMMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !smma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(smma.Count(), smma.Calc)
+}
+
+// T3 holds all the necessary information needed to calculate Tillson T3
+// moving average.
+// The zero value is not usable.
+type T3 struct {
+	// valid specifies whether T3 paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for t3 calculations.
+	ema EMA
+
+	// factor specifies the volume factor of generalized DEMAs.
+	factor float64
+}
+
+// NewT3 validates provided configuration options and creates new T3
+// indicator. The default volume factor of 0.7 is used.
+func NewT3(length int) (T3, error) {
+	return NewT3WithFactor(length, 0.7)
+}
+
+// NewT3WithFactor validates provided configuration options and creates
+// new T3 indicator with the provided volume factor, which must be between
+// 0 and 1.
+func NewT3WithFactor(length int, factor float64) (T3, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return T3{}, err
+	}
+
+	t3 := T3{
+		ema:    ema,
+		factor: factor,
+	}
+
+	if err := t3.validate(); err != nil {
+		return T3{}, err
+	}
+
+	return t3, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (t3 *T3) validate() error {
+	if t3.factor < 0 || t3.factor > 1 {
+		return tango.ErrInvalidFactor
+	}
+
+	t3.valid = true
+
+	return nil
+}
+
+// Calc calculates T3 from the provided data points slice.
+func (t3 T3) Calc(dd []float64) (float64, error) {
+	if !t3.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != t3.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	ee := t3.ema.chain(dd, 6)
+
+	return t3.calc(ee, len(ee[0])-1), nil
+}
+
+// calc calculates T3 at the provided index of the EMA series.
+func (t3 T3) calc(ee [][]float64, i int) float64 {
+	a := t3.factor
+	a2 := a * a
+	a3 := a2 * a
+
+	c1 := -a3
+	c2 := 3*a2 + 3*a3
+	c3 := -6*a2 - 3*a - 3*a3
+	c4 := 1 + 3*a + a3 + 3*a2
+
+	return c1*ee[5][i] + c2*ee[4][i] + c3*ee[3][i] + c4*ee[2][i]
+}
+
+// Count determines the total amount of data points needed for T3
+// calculation.
+func (t3 T3) Count() int {
+	return t3.ema.Count()
+}
+
+// CalcSeries calculates T3 for every window of Count() data points of
+// the provided slice. All six EMAs are recalculated for every window, so
+// the values are identical to the ones Calc produces.
+func (t3 T3) CalcSeries(dd []float64) ([]float64, error) {
+	if !t3.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(t3.Count(), dd, t3.Calc)
+}
+
+// CalcContinuousSeries calculates T3 over the whole provided slice. The
+// six chained EMAs are seeded once, so only the first value matches Calc.
+func (t3 T3) CalcContinuousSeries(dd []float64) ([]float64, error) {
+	if !t3.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < t3.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	ee := t3.ema.chain(dd, 6)
+	offset := t3.Count() - t3.ema.sma.length
+	res := make([]float64, len(ee[0])-offset)
+
+	for i := range res {
+		res[i] = t3.calc(ee, i+offset)
+	}
+
+	return res, nil
+}
+
+// Stream creates new T3 stream that calculates T3 from the most recent
+// data points each time a new data point is added.
+func (t3 T3) Stream() (*tango.Stream[float64, float64], error) {
+	if !t3.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(t3.Count(), t3.Calc)
+}
+
+// TEMA holds all the necessary information needed to calculate
+// triple exponential moving average.
+// The zero value is not usable.
+type TEMA struct {
+	// valid specifies whether TEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for tema calculations.
+	ema EMA
+}
+
+// NewTEMA validates provided configuration options and creates
+// new TEMA indicator.
+func NewTEMA(length int) (TEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return TEMA{}, err
+	}
+
+	return TEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates TEMA from the provided data points slice.
+func (tema TEMA) Calc(dd []float64) (float64, error) {
+	if !tema.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != tema.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	ee := tema.ema.chain(dd, 3)
+
+	return tema.calc(ee, len(ee[0])-1), nil
+}
+
+// calc calculates TEMA at the provided index of the EMA series.
+func (tema TEMA) calc(ee [][]float64, i int) float64 {
+	return 3*ee[0][i] - 3*ee[1][i] + ee[2][i]
+}
+
+// Count determines the total amount of data points needed for TEMA
+// calculation.
+func (tema TEMA) Count() int {
+	return tema.ema.Count()
+}
+
+// CalcSeries calculates TEMA for every window of Count() data points of
+// the provided slice. All three EMAs are recalculated for every window,
+// so the values are identical to the ones Calc produces.
+func (tema TEMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !tema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(tema.Count(), dd, tema.Calc)
+}
+
+// CalcContinuousSeries calculates TEMA over the whole provided slice.
+// The chained EMAs are seeded once from the first data points, so only
+// the first value matches Calc.
+func (tema TEMA) CalcContinuousSeries(dd []float64) ([]float64, error) {
+	if !tema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < tema.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	ee := tema.ema.chain(dd, 3)
+	offset := tema.Count() - tema.ema.sma.length
+	res := make([]float64, len(ee[0])-offset)
+
+	for i := range res {
+		res[i] = tema.calc(ee, i+offset)
+	}
+
+	return res, nil
+}
+
+// Stream creates new TEMA stream that calculates TEMA from the most recent
+// data points each time a new data point is added.
+func (tema TEMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !tema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(tema.Count(), tema.Calc)
+}
+
+// VWAP holds all the necessary information needed to calculate VWAP.
+// The zero value is not usable.
+type VWAP struct {
+	// valid specifies whether VWAP paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewVWAP validates provided configuration options and
+// creates new VWAP indicator.
+func NewVWAP(length int) (VWAP, error) {
+	vwap := VWAP{
+		length: length,
+	}
+
+	if err := vwap.validate(); err != nil {
+		return VWAP{}, err
+	}
+
+	return vwap, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (vwap *VWAP) validate() error {
+	if vwap.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	vwap.valid = true
+
+	return nil
+}
+
+// Calc calculates VWAP from the provided data points and volumes slices.
+func (vwap VWAP) Calc(dd, vv []float64) (float64, error) {
+	if !vwap.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != vwap.Count() || len(dd) != len(vv) {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	var res, volume float64
+
+	for i := range dd {
+		res += dd[i] * vv[i]
+		volume += vv[i]
+	}
+
+	if volume == 0 {
+		return dd[len(dd)-1], nil
+	}
+
+	return res / volume, nil
+}
+
+// CalcCandles calculates VWAP from the provided candles slice. Typical
+// price of each candle is weighted by its volume.
+func (vwap VWAP) CalcCandles(cc []Candle) (float64, error) {
+	dd := make([]float64, len(cc))
+	vv := make([]float64, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].TypicalPrice()
+		vv[i] = cc[i].Volume
+	}
+
+	return vwap.Calc(dd, vv)
+}
+
+// Count determines the total amount of data points needed for VWAP
+// calculation.
+func (vwap VWAP) Count() int {
+	return vwap.length
+}
+
+// CalcSeries calculates VWAP for every window of Count() data points and
+// volumes of the provided slices. Rolling sums are used, so the
+// calculation takes linear time.
+func (vwap VWAP) CalcSeries(dd, vv []float64) ([]float64, error) {
+	if !vwap.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < vwap.Count() || len(dd) != len(vv) {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res := make([]float64, len(dd)-vwap.Count()+1)
+
+	var sum, volume float64
+
+	for i := range dd {
+		sum += dd[i] * vv[i]
+		volume += vv[i]
+
+		if i >= vwap.length {
+			sum -= dd[i-vwap.length] * vv[i-vwap.length]
+			volume -= vv[i-vwap.length]
+		}
+
+		if i < vwap.length-1 {
+			continue
+		}
+
+		if volume == 0 {
+			res[i-vwap.length+1] = dd[i]
+			continue
+		}
+
+		res[i-vwap.length+1] = sum / volume
+	}
+
+	return res, nil
+}
+
+// AnchoredVWAPResult holds VWAP and its standard deviation bands
+// produced by a single anchored VWAP calculation.
+type AnchoredVWAPResult struct {
+	// VWAP is the volume-weighted average price since the anchor.
+	VWAP float64
+
+	// StdDev is the volume-weighted standard deviation of the prices
+	// since the anchor.
+	StdDev float64
+
+	// Upper1 is VWAP plus one standard deviation.
+	Upper1 float64
+
+	// Lower1 is VWAP minus one standard deviation.
+	Lower1 float64
+
+	// Upper2 is VWAP plus two standard deviations.
+	Upper2 float64
+
+	// Lower2 is VWAP minus two standard deviations.
+	Lower2 float64
+
+	// Upper3 is VWAP plus three standard deviations.
+	Upper3 float64
+
+	// Lower3 is VWAP minus three standard deviations.
+	Lower3 float64
+}
+
+// AnchoredVWAP holds all the necessary information needed to calculate
+// VWAP anchored to the first candle, to a specific candle or to the
+// opening of each session.
+// The zero value is not usable.
+type AnchoredVWAP struct {
+	// valid specifies whether AnchoredVWAP paremeters were validated.
+	valid bool
+
+	// anchor specifies the time of the candle from which the calculation
+	// starts. The first candle is used when it is zero.
+	anchor time.Time
+
+	// reset specifies whether the calculation should be restarted at
+	// the opening of each session.
+	reset bool
+
+	// session specifies the session used to reset the calculation.
+	session tango.Session
+}
+
+// NewAnchoredVWAP creates new AnchoredVWAP indicator that is anchored
+// to the first provided candle and is never reset.
+func NewAnchoredVWAP() (AnchoredVWAP, error) {
+	return AnchoredVWAP{
+		valid: true,
+	}, nil
+}
+
+// NewAnchoredVWAPAt creates new AnchoredVWAP indicator that is anchored
+// to the first candle opened at or after the provided time and is never
+// reset. Candles opened before the anchor are skipped.
+func NewAnchoredVWAPAt(anchor time.Time) (AnchoredVWAP, error) {
+	return AnchoredVWAP{
+		valid:  true,
+		anchor: anchor,
+	}, nil
+}
+
+// NewSessionVWAP validates provided configuration options and creates
+// new AnchoredVWAP indicator that is reset at the opening of each
+// session, based on the opening times of the candles.
+func NewSessionVWAP(session tango.Session) (AnchoredVWAP, error) {
+	if err := session.Validate(); err != nil {
+		return AnchoredVWAP{}, err
+	}
+
+	return AnchoredVWAP{
+		valid:   true,
+		reset:   true,
+		session: session,
+	}, nil
+}
+
+// Calc calculates VWAP and its standard deviation bands of the last
+// candle from the provided candles slice. The first candle, or the one
+// the indicator is anchored to, is used as the anchor, so any amount of
+// candles, not less than Count() since the anchor, is accepted.
+func (av AnchoredVWAP) Calc(cc []Candle) (AnchoredVWAPResult, error) {
+	res, err := av.CalcSeries(cc)
+	if err != nil {
+		return AnchoredVWAPResult{}, err
+	}
+
+	return res[len(res)-1], nil
+}
+
+// Count determines the minimum amount of candles needed for AnchoredVWAP
+// calculation.
+func (av AnchoredVWAP) Count() int {
+	return 1
+}
+
+// CalcSeries calculates VWAP and its standard deviation bands of every
+// candle of the provided slice, by accumulating them since the anchor or
+// the opening of the session. Candles opened before the anchor are
+// skipped, so the first value belongs to the anchor candle.
+func (av AnchoredVWAP) CalcSeries(cc []Candle) ([]AnchoredVWAPResult, error) {
+	if !av.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	for len(cc) > 0 && cc[0].Time.Before(av.anchor) {
+		cc = cc[1:]
+	}
+
+	if len(cc) < av.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res := make([]AnchoredVWAPResult, len(cc))
+
+	var state anchoredVWAPState
+
+	for i := range cc {
+		res[i] = av.update(&state, cc[i], i == 0)
+	}
+
+	return res, nil
+}
+
+// Stream creates new AnchoredVWAP stream that accumulates every added
+// candle since the anchor or the opening of the session.
+func (av AnchoredVWAP) Stream() (*AnchoredVWAPStream, error) {
+	if !av.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return &AnchoredVWAPStream{av: av}, nil
+}
+
+// update adds the candle to the state, resets it beforehand if the
+// candle opens a new session, and calculates the result.
+func (av AnchoredVWAP) update(state *anchoredVWAPState, c Candle, first bool) AnchoredVWAPResult {
+	var start time.Time
+	if av.reset {
+		start = av.session.StartOf(c.Time)
+	}
+
+	if first || !start.Equal(state.start) {
+		*state = anchoredVWAPState{start: start}
+	}
+
+	price := c.TypicalPrice()
+
+	state.last = price
+
+	// Weighted mean and squared deviations are updated incrementally,
+	// because subtracting the squared mean from the mean of squares loses
+	// most of the float64 precision when the deviation is small.
+	if c.Volume != 0 {
+		state.volume += c.Volume
+
+		delta := price - state.mean
+		state.mean += delta * c.Volume / state.volume
+		state.squares += c.Volume * delta * (price - state.mean)
+	}
+
+	return state.result()
+}
+
+// anchoredVWAPState holds the values accumulated since the anchor.
+type anchoredVWAPState struct {
+	// start specifies the opening time of the current session.
+	start time.Time
+
+	// last specifies the typical price of the last candle.
+	last float64
+
+	// mean specifies the volume weighted mean of the prices.
+	mean float64
+
+	// squares specifies the sum of volume weighted squared deviations
+	// from the mean.
+	squares float64
+
+	// volume specifies the sum of volumes.
+	volume float64
+}
+
+// result calculates VWAP and its standard deviation bands from the
+// accumulated values.
+func (state anchoredVWAPState) result() AnchoredVWAPResult {
+	vwap := state.last
+
+	var sdev float64
+
+	if state.volume != 0 {
+		vwap = state.mean
+
+		if state.squares > 0 {
+			sdev = math.Sqrt(state.squares / state.volume)
+		}
+	}
+
+	return AnchoredVWAPResult{
+		VWAP:   vwap,
+		StdDev: sdev,
+		Upper1: vwap + sdev,
+		Lower1: vwap - sdev,
+		Upper2: vwap + sdev*2,
+		Lower2: vwap - sdev*2,
+		Upper3: vwap + sdev*3,
+		Lower3: vwap - sdev*3,
+	}
+}
+
+// AnchoredVWAPStream holds the values accumulated by AnchoredVWAP and
+// recalculates it each time a new candle is added.
+// The zero value is not usable.
+type AnchoredVWAPStream struct {
+	// av specifies AnchoredVWAP indicator configuration.
+	av AnchoredVWAP
+
+	// state specifies the values accumulated since the anchor.
+	state anchoredVWAPState
+
+	// ready specifies whether at least one candle was added.
+	ready bool
+}
+
+// Update adds a new candle to the stream and calculates VWAP and its
+// standard deviation bands. The returned boolean reports whether the
+// stream has collected enough candles. Candles opened before the anchor
+// are skipped.
+func (s *AnchoredVWAPStream) Update(c Candle) (AnchoredVWAPResult, bool, error) {
+	if !s.av.valid {
+		return AnchoredVWAPResult{}, false, tango.ErrInvalidIndicator
+	}
+
+	if !s.ready && c.Time.Before(s.av.anchor) {
+		return AnchoredVWAPResult{}, false, nil
+	}
+
+	res := s.av.update(&s.state, c, !s.ready)
+	s.ready = true
+
+	return res, true, nil
+}
+
+// Ready determines whether the stream has collected enough candles
+// for the calculation.
+func (s *AnchoredVWAPStream) Ready() bool {
+	return s.ready
+}
+
+// Reset removes all accumulated candles from the stream, so the next
+// added candle becomes the new anchor.
+func (s *AnchoredVWAPStream) Reset() {
+	s.state = anchoredVWAPState{}
+	s.ready = false
+}
+
+// VWMA holds all the necessary information needed to calculate
+// volume-weighted moving average, which is VWAP of closing prices.
+// The zero value is not usable.
+type VWMA struct {
+	// valid specifies whether VWMA paremeters were validated.
+	valid bool
+
+	// vwap specifies the base volume-weighted average price.
+	vwap VWAP
+}
+
+// NewVWMA validates provided configuration options and
+// creates new VWMA indicator.
+func NewVWMA(length int) (VWMA, error) {
+	vwap, err := NewVWAP(length)
+	if err != nil {
+		return VWMA{}, err
+	}
+
+	return VWMA{
+		valid: true,
+		vwap:  vwap,
+	}, nil
+}
+
+// Calc calculates VWMA from the provided candles slice. Closing price of
+// each candle is weighted by its volume.
+func (vwma VWMA) Calc(cc []Candle) (float64, error) {
+	if !vwma.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	dd, vv := closesAndVolumes(cc)
+
+	return vwma.vwap.Calc(dd, vv)
+}
+
+// Count determines the total amount of candles needed for VWMA
+// calculation.
+func (vwma VWMA) Count() int {
+	return vwma.vwap.Count()
+}
+
+// CalcSeries calculates VWMA for every window of Count() candles of the
+// provided slice. Rolling sums are used, so the calculation takes linear
+// time.
+func (vwma VWMA) CalcSeries(cc []Candle) ([]float64, error) {
+	if !vwma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	dd, vv := closesAndVolumes(cc)
+
+	return vwma.vwap.CalcSeries(dd, vv)
+}
+
+// Stream creates new VWMA stream that calculates VWMA from the most recent
+// candles each time a new candle is added.
+func (vwma VWMA) Stream() (*tango.Stream[Candle, float64], error) {
+	if !vwma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(vwma.Count(), vwma.Calc)
+}
+
+// closesAndVolumes splits the provided candles into closing prices and
+// volumes.
+func closesAndVolumes(cc []Candle) (dd, vv []float64) {
+	dd = make([]float64, len(cc))
+	vv = make([]float64, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].Close
+		vv[i] = cc[i].Volume
+	}
+
+	return dd, vv
+}
+
+// WMA holds all the necessary information needed to calculate weighted
+// moving average.
+// The zero value is not usable.
+type WMA struct {
+	// valid specifies whether WMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewWMA validates provided configuration options and
+// creates new WMA indicator.
+func NewWMA(length int) (WMA, error) {
+	wma := WMA{
+		length: length,
+	}
+
+	if err := wma.validate(); err != nil {
+		return WMA{}, err
+	}
+
+	return wma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (wma *WMA) validate() error {
+	if wma.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	wma.valid = true
+
+	return nil
+}
+
+// Calc calculates WMA from the provided data points slice.
+func (wma WMA) Calc(dd []float64) (float64, error) {
+	if !wma.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != wma.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	return wma.calc(dd), nil
+}
+
+// calc calculates WMA without validating the indicator and the size of
+// the provided data points slice.
+func (wma WMA) calc(dd []float64) float64 {
+	weight := wma.weight()
+	if weight == 0 {
+		return 0
+	}
+
+	var res float64
+
+	for i := range dd {
+		res += dd[i] * float64(i+1)
+	}
+
+	return res / weight
+}
+
+// Count determines the total amount of data points needed for WMA
+// calculation.
+func (wma WMA) Count() int {
+	return wma.length
+}
+
+// CalcSeries calculates WMA for every window of Count() data points of
+// the provided slice. Rolling sums are used, so the calculation takes
+// linear time.
+func (wma WMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !wma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < wma.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	return wma.series(dd), nil
+}
+
+// series calculates WMA for every window of the provided slice without
+// validating the indicator. The slice must not be shorter than the length.
+func (wma WMA) series(dd []float64) []float64 {
+	res := make([]float64, len(dd)-wma.length+1)
+
+	weight := wma.weight()
+	if weight == 0 {
+		return res
+	}
+
+	length := float64(wma.length)
+
+	// sum holds the sum of the current window, while wsum holds
+	// the weighted sum of it.
+	var sum, wsum float64
+
+	for i := 0; i < wma.length; i++ {
+		sum += dd[i]
+		wsum += dd[i] * float64(i+1)
+	}
+
+	res[0] = wsum / weight
+
+	for i := wma.length; i < len(dd); i++ {
+		wsum = wsum - sum + dd[i]*length
+		sum = sum - dd[i-wma.length] + dd[i]
+		res[i-wma.length+1] = wsum / weight
+	}
+
+	return res
+}
+
+// weight calculates the sum of all WMA weights.
+func (wma WMA) weight() float64 {
+	return float64(wma.length*(wma.length+1)) / 2
+}
+
+// Stream creates new WMA stream that calculates WMA from the most recent
+// data points each time a new data point is added.
+func (wma WMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !wma.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(wma.Count(), wma.Calc)
+}
+
+// ZLEMA holds all the necessary information needed to calculate zero lag
+// exponential moving average.
+// The zero value is not usable.
+type ZLEMA struct {
+	// valid specifies whether ZLEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for zlema calculations.
+	ema EMA
+}
+
+// NewZLEMA validates provided configuration options and creates
+// new ZLEMA indicator.
+func NewZLEMA(length int) (ZLEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return ZLEMA{}, err
+	}
+
+	return ZLEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates ZLEMA from the provided data points slice. Every data
+// point is adjusted by its change over the lag of (length-1)/2 data points
+// before EMA is calculated.
+func (zlema ZLEMA) Calc(dd []float64) (float64, error) {
+	if !zlema.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) != zlema.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	res, err := zlema.ema.Calc(zlema.adjust(dd))
+	if err != nil {
+		// unlikely to happen
+		return 0, err
+	}
+
+	return res, nil
+}
+
+// adjust removes the lag from every data point of the provided slice,
+// except the first lag ones.
+func (zlema ZLEMA) adjust(dd []float64) []float64 {
+	lag := zlema.lag()
+	res := make([]float64, len(dd)-lag)
+
+	for i := range res {
+		res[i] = dd[i+lag] + (dd[i+lag] - dd[i])
+	}
+
+	return res
+}
+
+// lag calculates the amount of data points the lag is removed over.
+func (zlema ZLEMA) lag() int {
+	return (zlema.ema.sma.length - 1) / 2
+}
+
+// Count determines the total amount of data points needed for ZLEMA
+// calculation.
+func (zlema ZLEMA) Count() int {
+	return zlema.ema.Count() + zlema.lag()
+}
+
+// CalcSeries calculates ZLEMA for every window of Count() data points of
+// the provided slice. The adjusted data points of every window are passed
+// to a separately seeded EMA, so the values are identical to the ones
+// Calc produces.
+func (zlema ZLEMA) CalcSeries(dd []float64) ([]float64, error) {
+	if !zlema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(zlema.Count(), dd, zlema.Calc)
+}
+
+// CalcContinuousSeries calculates ZLEMA over the whole provided slice
+// with EMA.CalcContinuousSeries, so only the first value matches Calc.
+func (zlema ZLEMA) CalcContinuousSeries(dd []float64) ([]float64, error) {
+	if !zlema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(dd) < zlema.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	res, err := zlema.ema.CalcContinuousSeries(zlema.adjust(dd))
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Stream creates new ZLEMA stream that calculates ZLEMA from the most
+// recent data points each time a new data point is added.
+func (zlema ZLEMA) Stream() (*tango.Stream[float64, float64], error) {
+	if !zlema.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(zlema.Count(), zlema.Calc)
+}
@@ -0,0 +1,1321 @@
+package fast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jellydator/tango"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// seriesMA is a moving average that calculates series and streams.
+type seriesMA interface {
+	MA
+	CalcSeries([]float64) ([]float64, error)
+	Stream() (*tango.Stream[float64, float64], error)
+}
+
+// continuousMA is a moving average that can smooth over a whole series.
+type continuousMA interface {
+	CalcContinuousSeries([]float64) ([]float64, error)
+}
+
+// builtinMATypes returns all built-in moving average types.
+func builtinMATypes() []tango.MAType {
+	return []tango.MAType{
+		tango.MATypeDoubleExponential,
+		tango.MATypeExponential,
+		tango.MATypeHull,
+		tango.MATypeSimple,
+		tango.MATypeWeighted,
+		tango.MATypeSmoothed,
+		tango.MATypeTripleExponential,
+		tango.MATypeKaufmanAdaptive,
+		tango.MATypeArnaudLegoux,
+		tango.MATypeZeroLagExponential,
+		tango.MATypeTillson,
+	}
+}
+
+// assertMAMatches checks whether every float64 moving average value
+// matches the decimal one within the tolerance.
+func assertMAMatches(t *testing.T, tma tango.MA, ma seriesMA) {
+	t.Helper()
+
+	assertMACalcMatches(t, tma, ma)
+
+	s, err := ma.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ma.Count(), ma.Calc, crossCheckData())
+}
+
+// assertMACalcMatches checks whether every float64 moving average value
+// calculated by Calc and CalcSeries matches the decimal one within the
+// tolerance.
+func assertMACalcMatches(t *testing.T, tma tango.MA, ma seriesMA) {
+	t.Helper()
+
+	dd := crossCheckData()
+	tdd := decimals(dd)
+
+	assert.Equal(t, tma.Count(), ma.Count())
+
+	_, err := ma.Calc(dd[:ma.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = ma.CalcSeries(dd[:ma.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	for i := ma.Count(); i <= len(dd); i++ {
+		exp, err := tma.Calc(tdd[i-ma.Count() : i])
+		assert.NoError(t, err)
+
+		res, err := ma.Calc(dd[i-ma.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, exp, res)
+	}
+
+	exp, err := tango.CalcMASeries(tma, tdd)
+	assert.NoError(t, err)
+
+	res, err := ma.CalcSeries(dd)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, res)
+
+	cma, ok := ma.(continuousMA)
+	if !ok {
+		return
+	}
+
+	tcma, ok := tma.(interface {
+		CalcContinuousSeries([]decimal.Decimal) ([]decimal.Decimal, error)
+	})
+	assert.True(t, ok)
+
+	_, err = cma.CalcContinuousSeries(dd[:ma.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	exp, err = tcma.CalcContinuousSeries(tdd)
+	assert.NoError(t, err)
+
+	res, err = cma.CalcContinuousSeries(dd)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, res)
+}
+
+func Test_MA_CrossCheck(t *testing.T) {
+	for _, mat := range builtinMATypes() {
+		mat := mat
+
+		name, err := mat.MarshalText()
+		assert.NoError(t, err)
+
+		t.Run(string(name), func(t *testing.T) {
+			t.Parallel()
+
+			for _, length := range []int{1, 2, 3, 5, 8} {
+				tma, err := tango.NewMA(mat, length)
+				assert.NoError(t, err)
+
+				ma, err := NewMA(mat, length)
+				assert.NoError(t, err)
+
+				assertMAMatches(t, tma, ma.(seriesMA))
+			}
+		})
+	}
+}
+
+func Test_MA_Invalid(t *testing.T) {
+	cc := map[string]seriesMA{
+		"ALMA":  ALMA{},
+		"DEMA":  DEMA{},
+		"EMA":   EMA{},
+		"HMA":   HMA{},
+		"KAMA":  KAMA{},
+		"SMA":   SMA{},
+		"SMMA":  SMMA{},
+		"T3":    T3{},
+		"TEMA":  TEMA{},
+		"WMA":   WMA{},
+		"ZLEMA": ZLEMA{},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			_, err := c.Calc(crossCheckData())
+			assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+			_, err = c.CalcSeries(crossCheckData())
+			assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+			_, err = c.Stream()
+			assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+			if cma, ok := c.(continuousMA); ok {
+				_, err = cma.CalcContinuousSeries(crossCheckData())
+				assertEqualError(t, tango.ErrInvalidIndicator, err)
+			}
+		})
+	}
+}
+
+func Test_NewALMAWithOptions(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Offset float64
+		Sigma  float64
+		Result ALMA
+		Error  error
+	}{
+		"Invalid length": {
+			Offset: 0.5,
+			Sigma:  6,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Invalid offset": {
+			Length: 3,
+			Offset: -0.1,
+			Sigma:  6,
+			Error:  tango.ErrInvalidOffset,
+		},
+		"Invalid sigma": {
+			Length: 3,
+			Offset: 0.5,
+			Error:  tango.ErrInvalidSigma,
+		},
+		"Successfully created new ALMA": {
+			Length: 3,
+			Offset: 0.5,
+			Sigma:  4,
+			Result: ALMA{valid: true, length: 3, offset: 0.5, sigma: 4},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewALMAWithOptions(c.Length, c.Offset, c.Sigma)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ALMA_OptionsCrossCheck(t *testing.T) {
+	talma, err := tango.NewALMAWithOptions(5, decimal.RequireFromString("0.3"), decimal.NewFromInt(2))
+	assert.NoError(t, err)
+
+	alma, err := NewALMAWithOptions(5, 0.3, 2)
+	assert.NoError(t, err)
+
+	assertMAMatches(t, talma, alma)
+}
+
+func Test_NewT3WithFactor(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Factor float64
+		Result T3
+		Error  error
+	}{
+		"Invalid length": {
+			Factor: 0.5,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Invalid factor": {
+			Length: 3,
+			Factor: 1.5,
+			Error:  tango.ErrInvalidFactor,
+		},
+		"Successfully created new T3": {
+			Length: 3,
+			Factor: 0.5,
+			Result: T3{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 3}}, factor: 0.5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewT3WithFactor(c.Length, c.Factor)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_T3_FactorCrossCheck(t *testing.T) {
+	tt3, err := tango.NewT3WithFactor(3, decimal.RequireFromString("0.5"))
+	assert.NoError(t, err)
+
+	t3, err := NewT3WithFactor(3, 0.5)
+	assert.NoError(t, err)
+
+	assertMAMatches(t, tt3, t3)
+}
+
+func Test_EMA_CalcNext(t *testing.T) {
+	_, err := EMA{}.CalcNext(1, 2)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tema, err := tango.NewEMA(3)
+	assert.NoError(t, err)
+
+	ema, err := NewEMA(3)
+	assert.NoError(t, err)
+
+	exp, err := tema.CalcNext(decimal.NewFromInt(64), decimal.RequireFromString("64.5"))
+	assert.NoError(t, err)
+
+	res, err := ema.CalcNext(64, 64.5)
+	assert.NoError(t, err)
+	assertCloseTo(t, exp, res)
+}
+
+func Test_NewEMAWithOptions(t *testing.T) {
+	cc := map[string]struct {
+		Length  int
+		Options tango.EMAOptions
+		Result  EMA
+		Error   error
+	}{
+		"Invalid seed": {
+			Length:  3,
+			Options: tango.EMAOptions{Seed: 70},
+			Error:   tango.ErrInvalidEMASeed,
+		},
+		"Invalid alpha": {
+			Length:  3,
+			Options: tango.EMAOptions{Alpha: decimal.NewFromInt(2)},
+			Error:   tango.ErrInvalidFactor,
+		},
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new EMA with value seed": {
+			Length: 3,
+			Options: tango.EMAOptions{
+				Seed:  tango.EMASeedValue,
+				Value: decimal.NewFromInt(64),
+				Alpha: decimal.RequireFromString("0.5"),
+			},
+			Result: EMA{
+				valid: true,
+				sma:   SMA{valid: true, length: 3},
+				seed:  tango.EMASeedValue,
+				value: 64,
+				alpha: 0.5,
+			},
+		},
+		"Successfully created new EMA with first seed": {
+			Length: 3,
+			Options: tango.EMAOptions{
+				Seed:  tango.EMASeedFirst,
+				Value: decimal.NewFromInt(64),
+			},
+			Result: EMA{
+				valid: true,
+				sma:   SMA{valid: true, length: 3},
+				seed:  tango.EMASeedFirst,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewEMAWithOptions(c.Length, c.Options)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_EMA_OptionsCrossCheck(t *testing.T) {
+	cc := map[string]tango.EMAOptions{
+		"Simple seed with alpha": {
+			Alpha: decimal.NewFromInt(1).Div(decimal.NewFromInt(3)),
+		},
+		"First seed": {
+			Seed: tango.EMASeedFirst,
+		},
+		"Value seed with alpha": {
+			Seed:  tango.EMASeedValue,
+			Value: decimal.NewFromInt(64),
+			Alpha: decimal.RequireFromString("0.25"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			tema, err := tango.NewEMAWithOptions(5, c)
+			assert.NoError(t, err)
+
+			ema, err := NewEMAWithOptions(5, c)
+			assert.NoError(t, err)
+
+			if c.Seed == tango.EMASeedValue {
+				assertMACalcMatches(t, tema, ema)
+
+				_, err = ema.Stream()
+				assertEqualError(t, tango.ErrInvalidEMASeed, err)
+
+				return
+			}
+
+			assertMAMatches(t, tema, ema)
+		})
+	}
+}
+
+func Test_EMA_chain(t *testing.T) {
+	dd := crossCheckData()
+
+	cc := map[string]tango.EMAOptions{
+		"Simple seed": {},
+		"First seed": {
+			Seed: tango.EMASeedFirst,
+		},
+		"Provided value seed": {
+			Seed:  tango.EMASeedValue,
+			Value: decimal.NewFromInt(50),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			ema, err := NewEMAWithOptions(3, c)
+			assert.NoError(t, err)
+
+			ee := ema.chain(dd, 2)
+
+			exp, err := ema.CalcContinuousSeries(dd)
+			assert.NoError(t, err)
+			assert.Equal(t, exp, ee[0][len(ee[0])-len(exp):])
+
+			// the second series smooths the first one and both of them
+			// are seeded with the same value.
+			seed, next := ema.start(dd)
+			curr := [2]float64{seed, seed}
+
+			for i := next; i < len(dd); i++ {
+				curr[0] = ema.next(curr[0], dd[i])
+				curr[1] = ema.next(curr[1], curr[0])
+			}
+
+			assert.Equal(t, curr[1], ee[1][len(ee[1])-1])
+		})
+	}
+}
+
+func Test_SMMA_CalcNext(t *testing.T) {
+	_, err := SMMA{}.CalcNext(1, 2)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tsmma, err := tango.NewSMMA(3)
+	assert.NoError(t, err)
+
+	smma, err := NewSMMA(3)
+	assert.NoError(t, err)
+
+	exp, err := tsmma.CalcNext(decimal.NewFromInt(64), decimal.RequireFromString("64.5"))
+	assert.NoError(t, err)
+
+	res, err := smma.CalcNext(64, 64.5)
+	assert.NoError(t, err)
+	assertCloseTo(t, exp, res)
+}
+
+func Test_NewBB(t *testing.T) {
+	cc := map[string]struct {
+		MAType tango.MAType
+		StdDev float64
+		Length int
+		Result BB
+		Error  error
+	}{
+		"Invalid MA type": {
+			MAType: 70,
+			StdDev: 2,
+			Length: 3,
+			Error:  tango.ErrInvalidMA,
+		},
+		"Invalid standard deviation": {
+			MAType: tango.MATypeSimple,
+			Length: 3,
+			Error:  tango.ErrInvalidStandardDeviation,
+		},
+		"Successfully created new BB": {
+			MAType: tango.MATypeSimple,
+			StdDev: 2,
+			Length: 3,
+			Result: BB{
+				valid:  true,
+				stdDev: 2,
+				ma:     SMA{valid: true, length: 3},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewBB(c.MAType, c.StdDev, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewBBWithDeviation(t *testing.T) {
+	cc := map[string]struct {
+		MAType    tango.MAType
+		Deviation tango.Deviation
+		StdDev    float64
+		Length    int
+		Result    BB
+		Error     error
+	}{
+		"Invalid deviation": {
+			MAType:    tango.MATypeSimple,
+			Deviation: 70,
+			StdDev:    2,
+			Length:    3,
+			Error:     tango.ErrInvalidDeviation,
+		},
+		"Invalid MA type": {
+			MAType:    70,
+			Deviation: tango.DeviationPopulation,
+			StdDev:    2,
+			Length:    3,
+			Error:     tango.ErrInvalidMA,
+		},
+		"Invalid length": {
+			MAType:    tango.MATypeSimple,
+			Deviation: tango.DeviationPopulation,
+			StdDev:    2,
+			Error:     tango.ErrInvalidLength,
+		},
+		"Invalid standard deviation": {
+			MAType:    tango.MATypeSimple,
+			Deviation: tango.DeviationPopulation,
+			Length:    3,
+			Error:     tango.ErrInvalidStandardDeviation,
+		},
+		"Successfully created new BB": {
+			MAType:    tango.MATypeSimple,
+			Deviation: tango.DeviationPopulation,
+			StdDev:    2,
+			Length:    3,
+			Result: BB{
+				valid:  true,
+				stdDev: 2,
+				ma:     SMA{valid: true, length: 3},
+				dev:    tango.DeviationPopulation,
+			},
+		},
+		"Successfully created new BB with ATR deviation": {
+			MAType:    tango.MATypeSimple,
+			Deviation: tango.DeviationATR,
+			StdDev:    2,
+			Length:    3,
+			Result: BB{
+				valid:  true,
+				stdDev: 2,
+				ma:     SMA{valid: true, length: 3},
+				dev:    tango.DeviationATR,
+				atr: ATR{
+					valid: true,
+					ma:    SMA{valid: true, length: 3},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewBBWithDeviation(c.MAType, c.Deviation, c.StdDev, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_BB_Invalid(t *testing.T) {
+	cc, _ := crossCheckCandles()
+
+	_, _, _, err := BB{}.Calc(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, _, _, err = BB{}.CalcCandles(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = BB{}.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = BB{}.CalcSeriesCandles(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = BB{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	bb, err := NewBB(tango.MATypeSimple, 2, 5)
+	assert.NoError(t, err)
+
+	_, err = bb.CalcBand(crossCheckData()[:5], 70)
+	assertEqualError(t, tango.ErrInvalidBand, err)
+
+	_, err = bb.CalcBandCandles(cc[:5], 70)
+	assertEqualError(t, tango.ErrInvalidBand, err)
+
+	_, err = bb.CalcBand(crossCheckData()[:4], tango.BandUpper)
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = bb.CalcBandCandles(cc[:4], tango.BandUpper)
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = bb.CalcSeries(crossCheckData()[:4])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = bb.CalcSeriesCandles(cc[:4])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	bb, err = NewBBWithDeviation(tango.MATypeSimple, tango.DeviationATR, 2, 5)
+	assert.NoError(t, err)
+
+	_, _, _, err = bb.Calc(crossCheckData()[:bb.Count()])
+	assertEqualError(t, tango.ErrInvalidDeviation, err)
+
+	_, err = bb.CalcSeries(crossCheckData())
+	assertEqualError(t, tango.ErrInvalidDeviation, err)
+
+	_, err = bb.Stream()
+	assertEqualError(t, tango.ErrInvalidDeviation, err)
+}
+
+// assertBBSeriesCloseTo checks whether every float64 BB result matches
+// the corresponding decimal one within the tolerance.
+func assertBBSeriesCloseTo(t *testing.T, exp []tango.BBResult, res []BBResult) {
+	t.Helper()
+
+	if !assert.Len(t, res, len(exp)) {
+		return
+	}
+
+	for i := range exp {
+		assertCloseTo(t, exp[i].Upper, res[i].Upper)
+		assertCloseTo(t, exp[i].Middle, res[i].Middle)
+		assertCloseTo(t, exp[i].Lower, res[i].Lower)
+		assertCloseTo(t, exp[i].Width, res[i].Width)
+		assertCloseTo(t, exp[i].PercentB, res[i].PercentB)
+	}
+}
+
+func Test_BB_CrossCheck(t *testing.T) {
+	bands := []tango.Band{
+		tango.BandUpper,
+		tango.BandLower,
+		tango.BandWidth,
+		tango.BandMiddle,
+		tango.BandPercentB,
+	}
+
+	cc := map[string]struct {
+		MAType    tango.MAType
+		Deviation tango.Deviation
+	}{
+		"Simple population": {
+			MAType:    tango.MATypeSimple,
+			Deviation: tango.DeviationPopulation,
+		},
+		"Exponential sample": {
+			MAType:    tango.MATypeExponential,
+			Deviation: tango.DeviationSample,
+		},
+		"Weighted mean": {
+			MAType:    tango.MATypeWeighted,
+			Deviation: tango.DeviationMean,
+		},
+		"Smoothed ATR": {
+			MAType:    tango.MATypeSmoothed,
+			Deviation: tango.DeviationATR,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			tbb, err := tango.NewBBWithDeviation(c.MAType, c.Deviation, decimal.RequireFromString("2.5"), 5)
+			assert.NoError(t, err)
+
+			bb, err := NewBBWithDeviation(c.MAType, c.Deviation, 2.5, 5)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tbb.Count(), bb.Count())
+
+			dd := crossCheckData()
+			tdd := decimals(dd)
+			candles, tcandles := crossCheckCandles()
+
+			for i := bb.Count(); i <= len(dd); i++ {
+				expUpper, expLower, expWidth, err := tbb.CalcCandles(tcandles[i-bb.Count() : i])
+				assert.NoError(t, err)
+
+				upper, lower, width, err := bb.CalcCandles(candles[i-bb.Count() : i])
+				assert.NoError(t, err)
+				assertCloseTo(t, expUpper, upper)
+				assertCloseTo(t, expLower, lower)
+				assertCloseTo(t, expWidth, width)
+
+				for _, band := range bands {
+					exp, err := tbb.CalcBandCandles(tcandles[i-bb.Count():i], band)
+					assert.NoError(t, err)
+
+					res, err := bb.CalcBandCandles(candles[i-bb.Count():i], band)
+					assert.NoError(t, err)
+					assertCloseTo(t, exp, res)
+				}
+
+				if c.Deviation == tango.DeviationATR {
+					continue
+				}
+
+				expUpper, expLower, expWidth, err = tbb.Calc(tdd[i-bb.Count() : i])
+				assert.NoError(t, err)
+
+				upper, lower, width, err = bb.Calc(dd[i-bb.Count() : i])
+				assert.NoError(t, err)
+				assertCloseTo(t, expUpper, upper)
+				assertCloseTo(t, expLower, lower)
+				assertCloseTo(t, expWidth, width)
+
+				for _, band := range bands {
+					exp, err := tbb.CalcBand(tdd[i-bb.Count():i], band)
+					assert.NoError(t, err)
+
+					res, err := bb.CalcBand(dd[i-bb.Count():i], band)
+					assert.NoError(t, err)
+					assertCloseTo(t, exp, res)
+				}
+			}
+
+			exp, err := tbb.CalcSeriesCandles(tcandles)
+			assert.NoError(t, err)
+
+			res, err := bb.CalcSeriesCandles(candles)
+			assert.NoError(t, err)
+			assertBBSeriesCloseTo(t, exp, res)
+
+			if c.Deviation == tango.DeviationATR {
+				return
+			}
+
+			exp, err = tbb.CalcSeries(tdd)
+			assert.NoError(t, err)
+
+			res, err = bb.CalcSeries(dd)
+			assert.NoError(t, err)
+			assertBBSeriesCloseTo(t, exp, res)
+
+			s, err := bb.Stream()
+			assert.NoError(t, err)
+			assertStreamMatchesCalc(t, s, bb.Count(), func(dd []float64) (BBResult, error) {
+				res, sdev, err := bb.calc(dd)
+
+				return bb.result(dd[len(dd)-1], res, sdev), err
+			}, dd)
+		})
+	}
+}
+
+func Test_NewDC(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result DC
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new DC": {
+			Length: 5,
+			Result: DC{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDC(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_DC_CrossCheck(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	_, _, _, err := DC{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = DC{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = DC{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tdc, err := tango.NewDC(5)
+	assert.NoError(t, err)
+
+	dc, err := NewDC(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, tdc.Count(), dc.Count())
+
+	_, _, _, err = dc.Calc(cc[:dc.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = dc.CalcSeries(cc[:dc.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = dc.CalcBand(cc[:dc.Count()], tango.BandWidth)
+	assertEqualError(t, tango.ErrInvalidBand, err)
+
+	_, err = dc.CalcBand(cc[:dc.Count()-1], tango.BandUpper)
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	for i := dc.Count(); i <= len(cc); i++ {
+		expUpper, expMiddle, expLower, err := tdc.Calc(tcc[i-dc.Count() : i])
+		assert.NoError(t, err)
+
+		resUpper, resMiddle, resLower, err := dc.Calc(cc[i-dc.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, expUpper, resUpper)
+		assertCloseTo(t, expMiddle, resMiddle)
+		assertCloseTo(t, expLower, resLower)
+
+		for band, exp := range map[tango.Band]float64{
+			tango.BandUpper:  resUpper,
+			tango.BandMiddle: resMiddle,
+			tango.BandLower:  resLower,
+		} {
+			res, err := dc.CalcBand(cc[i-dc.Count():i], band)
+			assert.NoError(t, err)
+			assert.Equal(t, exp, res)
+		}
+	}
+
+	exp, err := tdc.CalcSeries(tcc)
+	assert.NoError(t, err)
+
+	res, err := dc.CalcSeries(cc)
+	assert.NoError(t, err)
+
+	if assert.Len(t, res, len(exp)) {
+		for i := range exp {
+			assertCloseTo(t, exp[i].Upper, res[i].Upper)
+			assertCloseTo(t, exp[i].Middle, res[i].Middle)
+			assertCloseTo(t, exp[i].Lower, res[i].Lower)
+		}
+	}
+
+	s, err := dc.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, dc.Count(), func(cc []Candle) (DCResult, error) {
+		upper, middle, lower, err := dc.Calc(cc)
+		return DCResult{Upper: upper, Middle: middle, Lower: lower}, err
+	}, cc)
+}
+
+func Test_NewKC(t *testing.T) {
+	cc := map[string]struct {
+		Type       tango.MAType
+		Length     int
+		ATRLength  int
+		Multiplier float64
+		Result     KC
+		Error      error
+	}{
+		"Invalid MA type": {
+			Length:     20,
+			ATRLength:  10,
+			Multiplier: 2,
+			Error:      tango.ErrInvalidMA,
+		},
+		"Invalid ATR length": {
+			Type:       tango.MATypeExponential,
+			Length:     20,
+			Multiplier: 2,
+			Error:      tango.ErrInvalidLength,
+		},
+		"Invalid multiplier": {
+			Type:      tango.MATypeExponential,
+			Length:    20,
+			ATRLength: 10,
+			Error:     tango.ErrInvalidFactor,
+		},
+		"Successfully created new KC": {
+			Type:       tango.MATypeSimple,
+			Length:     20,
+			ATRLength:  10,
+			Multiplier: 2,
+			Result: KC{
+				valid:      true,
+				multiplier: 2,
+				ma:         SMA{valid: true, length: 20},
+				atr: ATR{
+					valid: true,
+					ma:    SMMA{valid: true, sma: SMA{valid: true, length: 10}},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewKC(c.Type, c.Length, c.ATRLength, c.Multiplier)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_KC_CrossCheck(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	_, _, _, err := KC{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = KC{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = KC{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	for _, mat := range []tango.MAType{tango.MATypeSimple, tango.MATypeExponential} {
+		for _, length := range []int{3, 8} {
+			tkc, err := tango.NewKC(mat, length, 3, decimal.RequireFromString("1.5"))
+			assert.NoError(t, err)
+
+			kc, err := NewKC(mat, length, 3, 1.5)
+			assert.NoError(t, err)
+
+			assert.Equal(t, tkc.Count(), kc.Count())
+
+			_, _, _, err = kc.Calc(cc[:kc.Count()-1])
+			assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+			_, err = kc.CalcSeries(cc[:kc.Count()-1])
+			assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+			_, err = kc.CalcBand(cc[:kc.Count()], tango.BandWidth)
+			assertEqualError(t, tango.ErrInvalidBand, err)
+
+			_, err = kc.CalcBand(cc[:kc.Count()-1], tango.BandUpper)
+			assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+			for i := kc.Count(); i <= len(cc); i++ {
+				expUpper, expMiddle, expLower, err := tkc.Calc(tcc[i-kc.Count() : i])
+				assert.NoError(t, err)
+
+				resUpper, resMiddle, resLower, err := kc.Calc(cc[i-kc.Count() : i])
+				assert.NoError(t, err)
+				assertCloseTo(t, expUpper, resUpper)
+				assertCloseTo(t, expMiddle, resMiddle)
+				assertCloseTo(t, expLower, resLower)
+
+				for band, exp := range map[tango.Band]float64{
+					tango.BandUpper:  resUpper,
+					tango.BandMiddle: resMiddle,
+					tango.BandLower:  resLower,
+				} {
+					res, err := kc.CalcBand(cc[i-kc.Count():i], band)
+					assert.NoError(t, err)
+					assert.Equal(t, exp, res)
+				}
+			}
+
+			exp, err := tkc.CalcSeries(tcc)
+			assert.NoError(t, err)
+
+			res, err := kc.CalcSeries(cc)
+			assert.NoError(t, err)
+
+			if assert.Len(t, res, len(exp)) {
+				for i := range exp {
+					assertCloseTo(t, exp[i].Upper, res[i].Upper)
+					assertCloseTo(t, exp[i].Middle, res[i].Middle)
+					assertCloseTo(t, exp[i].Lower, res[i].Lower)
+				}
+			}
+
+			s, err := kc.Stream()
+			assert.NoError(t, err)
+			assertStreamMatchesCalc(t, s, kc.Count(), func(cc []Candle) (KCResult, error) {
+				upper, middle, lower, err := kc.Calc(cc)
+				return KCResult{Upper: upper, Middle: middle, Lower: lower}, err
+			}, cc)
+		}
+	}
+}
+
+func Test_NewVWAP(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result VWAP
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new VWAP": {
+			Length: 3,
+			Result: VWAP{valid: true, length: 3},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewVWAP(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_VWAP_CrossCheck(t *testing.T) {
+	_, err := VWAP{}.Calc(crossCheckData(), crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = VWAP{}.CalcSeries(crossCheckData(), crossCheckData())
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tvwap, err := tango.NewVWAP(5)
+	assert.NoError(t, err)
+
+	vwap, err := NewVWAP(5)
+	assert.NoError(t, err)
+
+	assert.Equal(t, tvwap.Count(), vwap.Count())
+
+	cc, tcc := crossCheckCandles()
+	dd := make([]float64, len(cc))
+	vv := make([]float64, len(cc))
+	tdd := make([]decimal.Decimal, len(tcc))
+	tvv := make([]decimal.Decimal, len(tcc))
+
+	for i := range cc {
+		dd[i], vv[i] = cc[i].Close, cc[i].Volume
+		tdd[i], tvv[i] = tcc[i].Close, tcc[i].Volume
+	}
+
+	_, err = vwap.Calc(dd[:5], vv[:4])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = vwap.CalcSeries(dd, vv[:4])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	res, err := vwap.Calc(dd[:5], make([]float64, 5))
+	assert.NoError(t, err)
+	assert.Equal(t, dd[4], res)
+
+	for i := vwap.Count(); i <= len(cc); i++ {
+		exp, err := tvwap.Calc(tdd[i-vwap.Count():i], tvv[i-vwap.Count():i])
+		assert.NoError(t, err)
+
+		res, err := vwap.Calc(dd[i-vwap.Count():i], vv[i-vwap.Count():i])
+		assert.NoError(t, err)
+		assertCloseTo(t, exp, res)
+
+		exp, err = tvwap.CalcCandles(tcc[i-vwap.Count() : i])
+		assert.NoError(t, err)
+
+		res, err = vwap.CalcCandles(cc[i-vwap.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, exp, res)
+	}
+
+	vv[7], vv[8], vv[9], vv[10], vv[11] = 0, 0, 0, 0, 0
+	tvv[7], tvv[8], tvv[9], tvv[10], tvv[11] = decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero, decimal.Zero
+
+	exp, err := tvwap.CalcSeries(tdd, tvv)
+	assert.NoError(t, err)
+
+	ress, err := vwap.CalcSeries(dd, vv)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, ress)
+}
+
+func Test_NewVWMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result VWMA
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new VWMA": {
+			Length: 5,
+			Result: VWMA{valid: true, vwap: VWAP{valid: true, length: 5}},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewVWMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_VWMA_CrossCheck(t *testing.T) {
+	cc, _ := crossCheckCandles()
+
+	_, err := VWMA{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = VWMA{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = VWMA{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tvwma, err := tango.NewVWMA(5)
+	assert.NoError(t, err)
+
+	vwma, err := NewVWMA(5)
+	assert.NoError(t, err)
+
+	assertCandleIndicatorMatches(t, tvwma, vwma)
+}
+
+func Test_NewAnchoredVWAP(t *testing.T) {
+	res, err := NewAnchoredVWAP()
+	assert.NoError(t, err)
+	assert.Equal(t, AnchoredVWAP{valid: true}, res)
+}
+
+func Test_NewAnchoredVWAPAt(t *testing.T) {
+	anchor := time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC)
+
+	res, err := NewAnchoredVWAPAt(anchor)
+	assert.NoError(t, err)
+	assert.Equal(t, AnchoredVWAP{valid: true, anchor: anchor}, res)
+}
+
+func Test_NewSessionVWAP(t *testing.T) {
+	cc := map[string]struct {
+		Session tango.Session
+		Result  AnchoredVWAP
+		Error   error
+	}{
+		"Invalid session": {
+			Session: tango.Session{Start: -time.Hour},
+			Error:   tango.ErrInvalidSession,
+		},
+		"Successfully created new session VWAP": {
+			Session: tango.Session{Start: time.Hour},
+			Result: AnchoredVWAP{
+				valid:   true,
+				reset:   true,
+				session: tango.Session{Start: time.Hour},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSessionVWAP(c.Session)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+// assertAnchoredVWAPCloseTo checks whether every float64 anchored VWAP
+// result matches the corresponding decimal one within the tolerance.
+func assertAnchoredVWAPCloseTo(t *testing.T, exp tango.AnchoredVWAPResult, res AnchoredVWAPResult) {
+	t.Helper()
+
+	assertCloseTo(t, exp.VWAP, res.VWAP)
+	assertCloseTo(t, exp.StdDev, res.StdDev)
+	assertCloseTo(t, exp.Upper1, res.Upper1)
+	assertCloseTo(t, exp.Lower1, res.Lower1)
+	assertCloseTo(t, exp.Upper2, res.Upper2)
+	assertCloseTo(t, exp.Lower2, res.Lower2)
+	assertCloseTo(t, exp.Upper3, res.Upper3)
+	assertCloseTo(t, exp.Lower3, res.Lower3)
+}
+
+func Test_AnchoredVWAP_CrossCheck(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	_, err := AnchoredVWAP{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = AnchoredVWAP{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = AnchoredVWAP{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, _, err = (&AnchoredVWAPStream{}).Update(cc[0])
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	// Volumes of a few candles are zeroed to verify that they don't
+	// affect the results.
+	for i := 40; i < 43; i++ {
+		cc[i].Volume = 0
+		tcc[i].Volume = decimal.Zero
+	}
+
+	anchor := cc[20].Time.Add(-time.Second)
+	session := tango.Session{Start: 10 * time.Hour}
+
+	tanchored, err := tango.NewAnchoredVWAP()
+	assert.NoError(t, err)
+
+	anchored, err := NewAnchoredVWAP()
+	assert.NoError(t, err)
+
+	tanchoredAt, err := tango.NewAnchoredVWAPAt(anchor)
+	assert.NoError(t, err)
+
+	anchoredAt, err := NewAnchoredVWAPAt(anchor)
+	assert.NoError(t, err)
+
+	tsession, err := tango.NewSessionVWAP(session)
+	assert.NoError(t, err)
+
+	sessionVWAP, err := NewSessionVWAP(session)
+	assert.NoError(t, err)
+
+	cases := map[string]struct {
+		Expected tango.AnchoredVWAP
+		Result   AnchoredVWAP
+		Skipped  int
+	}{
+		"Anchored to the first candle": {
+			Expected: tanchored,
+			Result:   anchored,
+		},
+		"Anchored at the given time": {
+			Expected: tanchoredAt,
+			Result:   anchoredAt,
+			Skipped:  20,
+		},
+		"Reset at the opening of each session": {
+			Expected: tsession,
+			Result:   sessionVWAP,
+		},
+	}
+
+	for cn, c := range cases {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.Expected.Count(), c.Result.Count())
+
+			_, err := c.Result.Calc(cc[:c.Skipped])
+			assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+			_, err = c.Result.CalcSeries(cc[:c.Skipped])
+			assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+			for i := c.Skipped + 1; i <= len(cc); i++ {
+				exp, err := c.Expected.Calc(tcc[:i])
+				assert.NoError(t, err)
+
+				res, err := c.Result.Calc(cc[:i])
+				assert.NoError(t, err)
+				assertAnchoredVWAPCloseTo(t, exp, res)
+			}
+
+			exp, err := c.Expected.CalcSeries(tcc)
+			assert.NoError(t, err)
+
+			res, err := c.Result.CalcSeries(cc)
+			assert.NoError(t, err)
+
+			if assert.Len(t, res, len(exp)) {
+				for i := range exp {
+					assertAnchoredVWAPCloseTo(t, exp[i], res[i])
+				}
+			}
+
+			s, err := c.Result.Stream()
+			assert.NoError(t, err)
+
+			for i := range cc {
+				sres, ok, err := s.Update(cc[i])
+				assert.NoError(t, err)
+				assert.Equal(t, i >= c.Skipped, ok)
+				assert.Equal(t, ok, s.Ready())
+
+				if ok {
+					assert.Equal(t, res[i-c.Skipped], sres)
+				}
+			}
+
+			s.Reset()
+			assert.False(t, s.Ready())
+		})
+	}
+}
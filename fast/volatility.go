@@ -0,0 +1,98 @@
+package fast
+
+import "github.com/jellydator/tango"
+
+// ATR holds all the necessary information needed to calculate average
+// true range.
+// The zero value is not usable.
+type ATR struct {
+	// valid specifies whether ATR paremeters were validated.
+	valid bool
+
+	// ma specifies MA indicator configuration used to smooth
+	// true range values.
+	ma MA
+}
+
+// NewATR validates provided configuration options and creates new ATR
+// indicator. Wilder's original smoothing is used with
+// tango.MATypeSmoothed, however any other moving average type may be
+// used as well.
+func NewATR(length int, mat tango.MAType) (ATR, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return ATR{}, err
+	}
+
+	return ATR{
+		valid: true,
+		ma:    ma,
+	}, nil
+}
+
+// Calc calculates ATR from the provided candles slice. The first candle
+// is used only for its closing price, which is needed to calculate the
+// true range of the second candle.
+func (atr ATR) Calc(cc []Candle) (float64, error) {
+	if !atr.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != atr.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	res, err := atr.ma.Calc(trueRanges(cc))
+	if err != nil {
+		// unlikely to happen
+		return 0, err
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for ATR
+// calculation.
+func (atr ATR) Count() int {
+	return atr.ma.Count() + 1
+}
+
+// CalcSeries calculates ATR for every window of Count() candles of the
+// provided slice. True ranges are calculated once for the whole series.
+func (atr ATR) CalcSeries(cc []Candle) ([]float64, error) {
+	if !atr.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < atr.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	return CalcMASeries(atr.ma, trueRanges(cc))
+}
+
+// Stream creates new ATR stream that calculates ATR from the most recent
+// candles each time a new candle is added.
+func (atr ATR) Stream() (*tango.Stream[Candle, float64], error) {
+	if !atr.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(atr.Count(), atr.Calc)
+}
+
+// trueRanges calculates true range of every candle, except the first
+// one, by using the closing price of the candle preceding it.
+func trueRanges(cc []Candle) []float64 {
+	if len(cc) < 2 {
+		return nil
+	}
+
+	res := make([]float64, len(cc)-1)
+
+	for i := range res {
+		res[i] = cc[i+1].TrueRange(cc[i])
+	}
+
+	return res
+}
@@ -0,0 +1,96 @@
+package fast
+
+import (
+	"testing"
+
+	"github.com/jellydator/tango"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewATR(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		MAType tango.MAType
+		Result ATR
+		Error  error
+	}{
+		"Invalid MA type": {
+			Length: 3,
+			MAType: 70,
+			Error:  tango.ErrInvalidMA,
+		},
+		"Invalid length": {
+			MAType: tango.MATypeSmoothed,
+			Error:  tango.ErrInvalidLength,
+		},
+		"Successfully created new ATR": {
+			Length: 3,
+			MAType: tango.MATypeSmoothed,
+			Result: ATR{
+				valid: true,
+				ma:    SMMA{valid: true, sma: SMA{valid: true, length: 3}},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewATR(c.Length, c.MAType)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ATR_CrossCheck(t *testing.T) {
+	cc, tcc := crossCheckCandles()
+
+	_, err := ATR{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ATR{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ATR{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	for _, mat := range []tango.MAType{tango.MATypeSimple, tango.MATypeSmoothed} {
+		tatr, err := tango.NewATR(5, mat)
+		assert.NoError(t, err)
+
+		atr, err := NewATR(5, mat)
+		assert.NoError(t, err)
+
+		assert.Equal(t, tatr.Count(), atr.Count())
+
+		_, err = atr.Calc(cc[:atr.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		_, err = atr.CalcSeries(cc[:atr.Count()-1])
+		assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+		for i := atr.Count(); i <= len(cc); i++ {
+			exp, err := tatr.Calc(tcc[i-atr.Count() : i])
+			assert.NoError(t, err)
+
+			res, err := atr.Calc(cc[i-atr.Count() : i])
+			assert.NoError(t, err)
+			assertCloseTo(t, exp, res)
+		}
+
+		exp, err := tatr.CalcSeries(tcc)
+		assert.NoError(t, err)
+
+		res, err := atr.CalcSeries(cc)
+		assert.NoError(t, err)
+		assertSeriesCloseTo(t, exp, res)
+
+		s, err := atr.Stream()
+		assert.NoError(t, err)
+		assertStreamMatchesCalc(t, s, atr.Count(), atr.Calc, cc)
+	}
+}
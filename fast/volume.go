@@ -0,0 +1,663 @@
+package fast
+
+import "github.com/jellydator/tango"
+
+// ADL holds all the necessary information needed to calculate
+// accumulation/distribution line.
+// The zero value is not usable.
+type ADL struct {
+	// valid specifies whether ADL paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewADL validates provided configuration options and
+// creates new ADL indicator.
+func NewADL(length int) (ADL, error) {
+	adl := ADL{
+		length: length,
+	}
+
+	if err := adl.validate(); err != nil {
+		return ADL{}, err
+	}
+
+	return adl, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (adl *ADL) validate() error {
+	if adl.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	adl.valid = true
+
+	return nil
+}
+
+// Calc calculates ADL from the provided candles slice. The result is only
+// the change of the line over the window, that is the sum of money flow
+// volumes of its candles, and not the line itself. CalcCumulativeSeries
+// should be used to get the running total of the line.
+func (adl ADL) Calc(cc []Candle) (float64, error) {
+	if !adl.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != adl.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	var res float64
+
+	for i := range cc {
+		res += moneyFlowVolume(cc[i])
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for ADL
+// calculation.
+func (adl ADL) Count() int {
+	return adl.length
+}
+
+// CalcSeries calculates ADL for every window of Count() candles of the
+// provided slice. Just like Calc, every window starts at zero. Rolling
+// sums are used, so the calculation takes linear time.
+func (adl ADL) CalcSeries(cc []Candle) ([]float64, error) {
+	if !adl.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < adl.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	ff := make([]float64, len(cc))
+
+	for i := range cc {
+		ff[i] = moneyFlowVolume(cc[i])
+	}
+
+	return rollingSums(ff, adl.Count()), nil
+}
+
+// CalcCumulativeSeries calculates the running total of ADL for every
+// candle of the provided slice. The line starts at zero before the first
+// candle of the slice.
+func (adl ADL) CalcCumulativeSeries(cc []Candle) ([]float64, error) {
+	if !adl.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < 1 {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	return accumulationDistribution(cc), nil
+}
+
+// Stream creates new ADL stream that calculates ADL from the most recent
+// candles each time a new candle is added.
+func (adl ADL) Stream() (*tango.Stream[Candle, float64], error) {
+	if !adl.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(adl.Count(), adl.Calc)
+}
+
+// ChaikinOsc holds all the necessary information needed to calculate
+// Chaikin oscillator.
+// The zero value is not usable.
+type ChaikinOsc struct {
+	// valid specifies whether ChaikinOsc paremeters were validated.
+	valid bool
+
+	// fast specifies fast MA indicator configuration.
+	fast MA
+
+	// slow specifies slow MA indicator configuration.
+	slow MA
+}
+
+// NewChaikinOsc validates provided configuration options and creates new
+// ChaikinOsc indicator. The same moving average type is used for fast
+// and slow lines, the original indicator uses 3 and 10 period EMAs.
+func NewChaikinOsc(fast, slow int, mat tango.MAType) (ChaikinOsc, error) {
+	fastMA, err := NewMA(mat, fast)
+	if err != nil {
+		return ChaikinOsc{}, err
+	}
+
+	slowMA, err := NewMA(mat, slow)
+	if err != nil {
+		return ChaikinOsc{}, err
+	}
+
+	co := ChaikinOsc{
+		fast: fastMA,
+		slow: slowMA,
+	}
+
+	if err := co.validate(); err != nil {
+		return ChaikinOsc{}, err
+	}
+
+	return co, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (co *ChaikinOsc) validate() error {
+	if co.fast.Count() >= co.slow.Count() {
+		return tango.ErrInvalidLength
+	}
+
+	co.valid = true
+
+	return nil
+}
+
+// Calc calculates ChaikinOsc from the provided candles slice.
+func (co ChaikinOsc) Calc(cc []Candle) (float64, error) {
+	if !co.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != co.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	adl := accumulationDistribution(cc)
+
+	fast, err := co.fast.Calc(adl[len(adl)-co.fast.Count():])
+	if err != nil {
+		// unlikely to happen
+		return 0, err
+	}
+
+	slow, err := co.slow.Calc(adl)
+	if err != nil {
+		// unlikely to happen
+		return 0, err
+	}
+
+	return fast - slow, nil
+}
+
+// Count determines the total amount of candles needed for ChaikinOsc
+// calculation.
+func (co ChaikinOsc) Count() int {
+	return co.slow.Count()
+}
+
+// CalcSeries calculates ChaikinOsc for every window of Count() candles
+// of the provided slice. The accumulation distribution line and both
+// moving averages are recalculated for every window, so the values are
+// identical to the ones Calc produces.
+func (co ChaikinOsc) CalcSeries(cc []Candle) ([]float64, error) {
+	if !co.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return calcWindows(co.Count(), cc, co.Calc)
+}
+
+// Stream creates new ChaikinOsc stream that calculates ChaikinOsc from
+// the most recent candles each time a new candle is added.
+func (co ChaikinOsc) Stream() (*tango.Stream[Candle, float64], error) {
+	if !co.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(co.Count(), co.Calc)
+}
+
+// CMF holds all the necessary information needed to calculate Chaikin
+// money flow.
+// The zero value is not usable.
+type CMF struct {
+	// valid specifies whether CMF paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewCMF validates provided configuration options and
+// creates new CMF indicator.
+func NewCMF(length int) (CMF, error) {
+	cmf := CMF{
+		length: length,
+	}
+
+	if err := cmf.validate(); err != nil {
+		return CMF{}, err
+	}
+
+	return cmf, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (cmf *CMF) validate() error {
+	if cmf.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	cmf.valid = true
+
+	return nil
+}
+
+// Calc calculates CMF from the provided candles slice.
+func (cmf CMF) Calc(cc []Candle) (float64, error) {
+	if !cmf.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != cmf.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	var flow, volume float64
+
+	for i := range cc {
+		flow += moneyFlowVolume(cc[i])
+		volume += cc[i].Volume
+	}
+
+	return cmf.calc(flow, volume), nil
+}
+
+// calc calculates CMF from the sums of money flow volumes and volumes.
+func (cmf CMF) calc(flow, volume float64) float64 {
+	if volume == 0 {
+		return 0
+	}
+
+	return flow / volume
+}
+
+// Count determines the total amount of candles needed for CMF
+// calculation.
+func (cmf CMF) Count() int {
+	return cmf.length
+}
+
+// CalcSeries calculates CMF for every window of Count() candles of the
+// provided slice. Rolling sums are used, so the calculation takes linear
+// time.
+func (cmf CMF) CalcSeries(cc []Candle) ([]float64, error) {
+	if !cmf.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < cmf.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	ff := make([]float64, len(cc))
+	vv := make([]float64, len(cc))
+
+	for i := range cc {
+		ff[i] = moneyFlowVolume(cc[i])
+		vv[i] = cc[i].Volume
+	}
+
+	flows := rollingSums(ff, cmf.Count())
+	volumes := rollingSums(vv, cmf.Count())
+	res := make([]float64, len(flows))
+
+	for i := range res {
+		res[i] = cmf.calc(flows[i], volumes[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new CMF stream that calculates CMF from the most recent
+// candles each time a new candle is added.
+func (cmf CMF) Stream() (*tango.Stream[Candle, float64], error) {
+	if !cmf.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(cmf.Count(), cmf.Calc)
+}
+
+// MFI holds all the necessary information needed to calculate money
+// flow index.
+// The zero value is not usable.
+type MFI struct {
+	// valid specifies whether MFI paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewMFI validates provided configuration options and
+// creates new MFI indicator.
+func NewMFI(length int) (MFI, error) {
+	mfi := MFI{
+		length: length,
+	}
+
+	if err := mfi.validate(); err != nil {
+		return MFI{}, err
+	}
+
+	return mfi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (mfi *MFI) validate() error {
+	if mfi.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	mfi.valid = true
+
+	return nil
+}
+
+// Calc calculates MFI from the provided candles slice. The first candle
+// is used only for its typical price, which is needed to determine the
+// direction of the money flow of the second candle.
+func (mfi MFI) Calc(cc []Candle) (float64, error) {
+	if !mfi.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != mfi.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	var pos, neg float64
+
+	pp, nn := moneyFlows(cc)
+
+	for i := range pp {
+		pos += pp[i]
+		neg += nn[i]
+	}
+
+	return mfi.calc(pos, neg), nil
+}
+
+// calc calculates MFI from the sums of positive and negative money flows.
+// A window without any money flow produces the neutral value of 50.
+func (mfi MFI) calc(pos, neg float64) float64 {
+	if pos == 0 && neg == 0 {
+		return 50
+	}
+
+	if neg == 0 {
+		return 100
+	}
+
+	return pos * 100 / (pos + neg)
+}
+
+// Count determines the total amount of candles needed for MFI
+// calculation.
+func (mfi MFI) Count() int {
+	return mfi.length + 1
+}
+
+// CalcSeries calculates MFI for every window of Count() candles of the
+// provided slice. Rolling sums are used, so the calculation takes linear
+// time.
+func (mfi MFI) CalcSeries(cc []Candle) ([]float64, error) {
+	if !mfi.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < mfi.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	pp, nn := moneyFlows(cc)
+	pos := rollingSums(pp, mfi.length)
+	neg := rollingSums(nn, mfi.length)
+
+	// Rolling sums may drift from zero, so money flows are counted to
+	// detect the windows that have none of them.
+	posCounts := rollingSums(flowCounts(pp), mfi.length)
+	negCounts := rollingSums(flowCounts(nn), mfi.length)
+	res := make([]float64, len(pos))
+
+	for i := range res {
+		if posCounts[i] == 0 {
+			pos[i] = 0
+		}
+
+		if negCounts[i] == 0 {
+			neg[i] = 0
+		}
+
+		res[i] = mfi.calc(pos[i], neg[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new MFI stream that calculates MFI from the most recent
+// candles each time a new candle is added.
+func (mfi MFI) Stream() (*tango.Stream[Candle, float64], error) {
+	if !mfi.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(mfi.Count(), mfi.Calc)
+}
+
+// OBV holds all the necessary information needed to calculate
+// on-balance volume.
+// The zero value is not usable.
+type OBV struct {
+	// valid specifies whether OBV paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewOBV validates provided configuration options and
+// creates new OBV indicator.
+func NewOBV(length int) (OBV, error) {
+	obv := OBV{
+		length: length,
+	}
+
+	if err := obv.validate(); err != nil {
+		return OBV{}, err
+	}
+
+	return obv, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (obv *OBV) validate() error {
+	if obv.length < 1 {
+		return tango.ErrInvalidLength
+	}
+
+	obv.valid = true
+
+	return nil
+}
+
+// Calc calculates OBV from the provided candles slice. The first candle
+// is used only for its closing price and the result is only the change of
+// OBV over the window, that is the sum of signed volumes of the other
+// candles, and not the line itself. CalcCumulativeSeries should be used to
+// get the running total of the line.
+func (obv OBV) Calc(cc []Candle) (float64, error) {
+	if !obv.valid {
+		return 0, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) != obv.Count() {
+		return 0, tango.ErrInvalidDataSize
+	}
+
+	var res float64
+
+	for _, v := range signedVolumes(cc) {
+		res += v
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for OBV
+// calculation.
+func (obv OBV) Count() int {
+	return obv.length + 1
+}
+
+// CalcSeries calculates OBV for every window of Count() candles of the
+// provided slice. Just like Calc, every window starts at zero. Rolling
+// sums are used, so the calculation takes linear time.
+func (obv OBV) CalcSeries(cc []Candle) ([]float64, error) {
+	if !obv.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < obv.Count() {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	return rollingSums(signedVolumes(cc), obv.length), nil
+}
+
+// CalcCumulativeSeries calculates the running total of OBV for every
+// candle of the provided slice. The line starts at zero at the first
+// candle of the slice.
+func (obv OBV) CalcCumulativeSeries(cc []Candle) ([]float64, error) {
+	if !obv.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	if len(cc) < 1 {
+		return nil, tango.ErrInvalidDataSize
+	}
+
+	vv := signedVolumes(cc)
+	res := make([]float64, len(cc))
+
+	for i := range vv {
+		res[i+1] = res[i] + vv[i]
+	}
+
+	return res, nil
+}
+
+// Stream creates new OBV stream that calculates OBV from the most recent
+// candles each time a new candle is added.
+func (obv OBV) Stream() (*tango.Stream[Candle, float64], error) {
+	if !obv.valid {
+		return nil, tango.ErrInvalidIndicator
+	}
+
+	return tango.NewStream(obv.Count(), obv.Calc)
+}
+
+// moneyFlowVolume calculates money flow volume of the candle, which is
+// its volume weighted by the position of the close within the range.
+func moneyFlowVolume(c Candle) float64 {
+	if c.Range() == 0 {
+		return 0
+	}
+
+	return ((c.Close - c.Low) - (c.High - c.Close)) / c.Range() * c.Volume
+}
+
+// accumulationDistribution calculates accumulation/distribution line
+// value of every candle, starting from zero before the first one.
+func accumulationDistribution(cc []Candle) []float64 {
+	res := make([]float64, len(cc))
+
+	var curr float64
+
+	for i := range cc {
+		curr += moneyFlowVolume(cc[i])
+		res[i] = curr
+	}
+
+	return res
+}
+
+// signedVolumes calculates the volume of every candle, except the first
+// one, signed by the direction of its closing price compared to the one
+// of the candle preceding it. Unchanged closing prices produce zero.
+func signedVolumes(cc []Candle) []float64 {
+	if len(cc) < 2 {
+		return nil
+	}
+
+	res := make([]float64, len(cc)-1)
+
+	for i := range res {
+		switch {
+		case cc[i+1].Close > cc[i].Close:
+			res[i] = cc[i+1].Volume
+		case cc[i+1].Close < cc[i].Close:
+			res[i] = -cc[i+1].Volume
+		}
+	}
+
+	return res
+}
+
+// moneyFlows calculates positive and negative money flows of every
+// candle, except the first one, by comparing its typical price with the
+// one of the candle preceding it.
+func moneyFlows(cc []Candle) (pos, neg []float64) {
+	if len(cc) < 2 {
+		return nil, nil
+	}
+
+	pos = make([]float64, len(cc)-1)
+	neg = make([]float64, len(cc)-1)
+
+	prev := cc[0].TypicalPrice()
+
+	for i := range pos {
+		curr := cc[i+1].TypicalPrice()
+
+		switch {
+		case curr > prev:
+			pos[i] = curr * cc[i+1].Volume
+		case curr < prev:
+			neg[i] = curr * cc[i+1].Volume
+		}
+
+		prev = curr
+	}
+
+	return pos, neg
+}
+
+// flowCounts marks every non-zero money flow with 1.
+func flowCounts(ff []float64) []float64 {
+	res := make([]float64, len(ff))
+
+	for i := range ff {
+		if ff[i] != 0 {
+			res[i] = 1
+		}
+	}
+
+	return res
+}
@@ -0,0 +1,390 @@
+package fast
+
+import (
+	"testing"
+	"time"
+
+	"github.com/jellydator/tango"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// candleIndicator is an indicator that calculates float64 values from
+// candles.
+type candleIndicator interface {
+	Calc([]Candle) (float64, error)
+	Count() int
+	CalcSeries([]Candle) ([]float64, error)
+	Stream() (*tango.Stream[Candle, float64], error)
+}
+
+// tangoCandleIndicator is an indicator that calculates decimal values
+// from candles.
+type tangoCandleIndicator interface {
+	Calc([]tango.Candle) (decimal.Decimal, error)
+	Count() int
+	CalcSeries([]tango.Candle) ([]decimal.Decimal, error)
+}
+
+// volumeCrossCheckCandles returns cross check candles followed by rising
+// candles that have neither a range nor a volume, so that the edge cases
+// of volume indicators are verified as well.
+func volumeCrossCheckCandles() ([]Candle, []tango.Candle) {
+	cc, tcc := crossCheckCandles()
+
+	for i := 0; i < 6; i++ {
+		price := 70 + float64(i)
+		tm := tcc[len(tcc)-1].Time.Add(time.Minute)
+
+		cc = append(cc, Candle{Time: tm, Open: price, High: price, Low: price, Close: price})
+		tcc = append(tcc, tango.Candle{
+			Time:     tm,
+			Interval: time.Minute,
+			Open:     decimal.NewFromFloat(price),
+			High:     decimal.NewFromFloat(price),
+			Low:      decimal.NewFromFloat(price),
+			Close:    decimal.NewFromFloat(price),
+		})
+	}
+
+	return cc, tcc
+}
+
+// assertCandleIndicatorMatches checks whether the float64 indicator
+// produces the same values as the decimal one.
+func assertCandleIndicatorMatches(t *testing.T, tind tangoCandleIndicator, ind candleIndicator) {
+	t.Helper()
+
+	cc, tcc := volumeCrossCheckCandles()
+
+	assert.Equal(t, tind.Count(), ind.Count())
+
+	_, err := ind.Calc(cc[:ind.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	_, err = ind.CalcSeries(cc[:ind.Count()-1])
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	for i := ind.Count(); i <= len(cc); i++ {
+		exp, err := tind.Calc(tcc[i-ind.Count() : i])
+		assert.NoError(t, err)
+
+		res, err := ind.Calc(cc[i-ind.Count() : i])
+		assert.NoError(t, err)
+		assertCloseTo(t, exp, res)
+	}
+
+	exp, err := tind.CalcSeries(tcc)
+	assert.NoError(t, err)
+
+	res, err := ind.CalcSeries(cc)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, res)
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, cc)
+}
+
+func Test_NewADL(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ADL
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new ADL": {
+			Length: 5,
+			Result: ADL{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewADL(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ADL_CrossCheck(t *testing.T) {
+	cc, _ := crossCheckCandles()
+
+	_, err := ADL{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ADL{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ADL{}.CalcCumulativeSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ADL{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tadl, err := tango.NewADL(5)
+	assert.NoError(t, err)
+
+	adl, err := NewADL(5)
+	assert.NoError(t, err)
+
+	assertCandleIndicatorMatches(t, tadl, adl)
+
+	_, err = adl.CalcCumulativeSeries(nil)
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	cc, tcc := volumeCrossCheckCandles()
+
+	exp, err := tadl.CalcCumulativeSeries(tcc)
+	assert.NoError(t, err)
+
+	res, err := adl.CalcCumulativeSeries(cc)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, res)
+}
+
+func Test_NewChaikinOsc(t *testing.T) {
+	cc := map[string]struct {
+		Fast   int
+		Slow   int
+		Type   tango.MAType
+		Result ChaikinOsc
+		Error  error
+	}{
+		"Invalid fast length": {
+			Slow:  5,
+			Type:  tango.MATypeSimple,
+			Error: tango.ErrInvalidLength,
+		},
+		"Invalid slow length": {
+			Fast:  3,
+			Type:  tango.MATypeSimple,
+			Error: tango.ErrInvalidLength,
+		},
+		"Invalid MA type": {
+			Fast:  3,
+			Slow:  5,
+			Error: tango.ErrInvalidMA,
+		},
+		"Fast length not less than slow length": {
+			Fast:  5,
+			Slow:  3,
+			Type:  tango.MATypeSimple,
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new ChaikinOsc": {
+			Fast: 3,
+			Slow: 5,
+			Type: tango.MATypeSimple,
+			Result: ChaikinOsc{
+				valid: true,
+				fast:  SMA{valid: true, length: 3},
+				slow:  SMA{valid: true, length: 5},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewChaikinOsc(c.Fast, c.Slow, c.Type)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ChaikinOsc_CrossCheck(t *testing.T) {
+	cc, _ := crossCheckCandles()
+
+	_, err := ChaikinOsc{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ChaikinOsc{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = ChaikinOsc{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	for _, mat := range []tango.MAType{tango.MATypeSimple, tango.MATypeExponential} {
+		tco, err := tango.NewChaikinOsc(3, 10, mat)
+		assert.NoError(t, err)
+
+		co, err := NewChaikinOsc(3, 10, mat)
+		assert.NoError(t, err)
+
+		assertCandleIndicatorMatches(t, tco, co)
+	}
+}
+
+func Test_NewCMF(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result CMF
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new CMF": {
+			Length: 5,
+			Result: CMF{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCMF(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CMF_CrossCheck(t *testing.T) {
+	cc, _ := crossCheckCandles()
+
+	_, err := CMF{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = CMF{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = CMF{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tcmf, err := tango.NewCMF(5)
+	assert.NoError(t, err)
+
+	cmf, err := NewCMF(5)
+	assert.NoError(t, err)
+
+	assertCandleIndicatorMatches(t, tcmf, cmf)
+}
+
+func Test_NewMFI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result MFI
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new MFI": {
+			Length: 5,
+			Result: MFI{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMFI(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_MFI_CrossCheck(t *testing.T) {
+	cc, _ := crossCheckCandles()
+
+	_, err := MFI{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = MFI{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = MFI{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tmfi, err := tango.NewMFI(5)
+	assert.NoError(t, err)
+
+	mfi, err := NewMFI(5)
+	assert.NoError(t, err)
+
+	assertCandleIndicatorMatches(t, tmfi, mfi)
+}
+
+func Test_NewOBV(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result OBV
+		Error  error
+	}{
+		"Invalid length": {
+			Error: tango.ErrInvalidLength,
+		},
+		"Successfully created new OBV": {
+			Length: 5,
+			Result: OBV{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewOBV(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_OBV_CrossCheck(t *testing.T) {
+	cc, _ := crossCheckCandles()
+
+	_, err := OBV{}.Calc(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = OBV{}.CalcSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = OBV{}.CalcCumulativeSeries(cc)
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	_, err = OBV{}.Stream()
+	assertEqualError(t, tango.ErrInvalidIndicator, err)
+
+	tobv, err := tango.NewOBV(5)
+	assert.NoError(t, err)
+
+	obv, err := NewOBV(5)
+	assert.NoError(t, err)
+
+	assertCandleIndicatorMatches(t, tobv, obv)
+
+	_, err = obv.CalcCumulativeSeries(nil)
+	assertEqualError(t, tango.ErrInvalidDataSize, err)
+
+	cc, tcc := volumeCrossCheckCandles()
+
+	exp, err := tobv.CalcCumulativeSeries(tcc)
+	assert.NoError(t, err)
+
+	res, err := obv.CalcCumulativeSeries(cc)
+	assert.NoError(t, err)
+	assertSeriesCloseTo(t, exp, res)
+}
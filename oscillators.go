@@ -4,6 +4,250 @@ package tango
 
 import "github.com/shopspring/decimal"
 
+// ADXResult holds all values produced by a single ADX calculation.
+type ADXResult struct {
+	// ADX is the average directional index value.
+	ADX decimal.Decimal
+
+	// PlusDI is the positive directional indicator (+DI) value.
+	PlusDI decimal.Decimal
+
+	// MinusDI is the negative directional indicator (-DI) value.
+	MinusDI decimal.Decimal
+}
+
+// ADX holds all the necessary information needed to calculate average
+// directional index together with the positive and negative directional
+// indicators.
+// The zero value is not usable.
+type ADX struct {
+	// valid specifies whether ADX paremeters were validated.
+	valid bool
+
+	// smma specifies Wilder's moving average configuration used to smooth
+	// directional movements, true ranges and directional index values.
+	smma SMMA
+}
+
+// NewADX validates provided configuration options and
+// creates new ADX indicator instance.
+func NewADX(length int) (ADX, error) {
+	smma, err := NewSMMA(length)
+	if err != nil {
+		return ADX{}, err
+	}
+
+	return ADX{
+		valid: true,
+		smma:  smma,
+	}, nil
+}
+
+// Calc calculates ADX, +DI and -DI from the provided candles slice.
+// Directional movements and true ranges are smoothed with Wilder's moving
+// average before the directional index values are smoothed the same way.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/a/adx.asp.
+// All credits are due to J. Welles Wilder Jr. who developed ADX indicator.
+func (adx ADX) Calc(cc []Candle) (
+	adxv decimal.Decimal,
+	plusDI decimal.Decimal,
+	minusDI decimal.Decimal,
+	err error,
+) {
+
+	if !adx.valid {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != adx.Count() {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := adx.calc(cc)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, decimal.Zero, err
+	}
+
+	return res[0].ADX, res[0].PlusDI, res[0].MinusDI, nil
+}
+
+// CalcTrend calculates specified directional indicator from the provided
+// candles slice. TrendUp returns +DI, while TrendDown returns -DI.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/a/adx.asp.
+// All credits are due to J. Welles Wilder Jr. who developed ADX indicator.
+func (adx ADX) CalcTrend(cc []Candle, trend Trend) (decimal.Decimal, error) {
+	if err := trend.Validate(); err != nil {
+		return decimal.Zero, err
+	}
+
+	_, plusDI, minusDI, err := adx.Calc(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	if trend == TrendDown {
+		return minusDI, nil
+	}
+
+	return plusDI, nil
+}
+
+// calc calculates ADX, +DI and -DI from the provided candles slice. The
+// smoothed values are carried over from one candle to the next, so the
+// first result covers the first Count() candles, while every following one
+// adds a single candle.
+func (adx ADX) calc(cc []Candle) ([]ADXResult, error) {
+	plusDM, minusDM := directionalMovements(cc)
+
+	plus, err := adx.smma.CalcContinuousSeries(plusDM)
+	if err != nil {
+		return nil, err
+	}
+
+	minus, err := adx.smma.CalcContinuousSeries(minusDM)
+	if err != nil {
+		return nil, err
+	}
+
+	tr, err := adx.smma.CalcContinuousSeries(trueRanges(cc))
+	if err != nil {
+		return nil, err
+	}
+
+	plusDI := make([]decimal.Decimal, len(tr))
+	minusDI := make([]decimal.Decimal, len(tr))
+	dx := make([]decimal.Decimal, len(tr))
+
+	for i := range tr {
+		plusDI[i] = calcDirectionalIndicator(plus[i], tr[i])
+		minusDI[i] = calcDirectionalIndicator(minus[i], tr[i])
+
+		sum := plusDI[i].Add(minusDI[i])
+		if !sum.IsZero() {
+			dx[i] = plusDI[i].Sub(minusDI[i]).Abs().Div(sum).Mul(_hundred)
+		}
+	}
+
+	aa, err := adx.smma.CalcContinuousSeries(dx)
+	if err != nil {
+		return nil, err
+	}
+
+	offset := len(dx) - len(aa)
+	res := make([]ADXResult, len(aa))
+
+	for i := range aa {
+		res[i] = ADXResult{
+			ADX:     aa[i],
+			PlusDI:  plusDI[i+offset],
+			MinusDI: minusDI[i+offset],
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for ADX
+// calculation.
+func (adx ADX) Count() int {
+	return adx.smma.Count() * 2
+}
+
+// CalcSeries calculates ADX, +DI and -DI for every window of Count()
+// candles of the provided slice. Wilder's smoothing is restarted for every
+// window, so the values are identical to the ones Calc produces.
+func (adx ADX) CalcSeries(cc []Candle) ([]ADXResult, error) {
+	if !adx.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(adx.Count(), cc, func(cc []Candle) (ADXResult, error) {
+		adxv, plusDI, minusDI, err := adx.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return ADXResult{}, err
+		}
+
+		return ADXResult{
+			ADX:     adxv,
+			PlusDI:  plusDI,
+			MinusDI: minusDI,
+		}, nil
+	})
+}
+
+// Stream creates new ADX stream that calculates ADX, +DI and -DI from
+// the most recent candles each time a new candle is added.
+func (adx ADX) Stream() (*Stream[Candle, ADXResult], error) {
+	if !adx.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(adx.Count(), func(cc []Candle) (ADXResult, error) {
+		adxv, plusDI, minusDI, err := adx.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return ADXResult{}, err
+		}
+
+		return ADXResult{
+			ADX:     adxv,
+			PlusDI:  plusDI,
+			MinusDI: minusDI,
+		}, nil
+	})
+}
+
+// directionalMovements calculates positive and negative directional
+// movements of every candle, except the first one, by comparing its high
+// and low prices with the ones of the candle preceding it.
+func directionalMovements(cc []Candle) (plus, minus []decimal.Decimal) {
+	if len(cc) < 2 {
+		return nil, nil
+	}
+
+	plus = make([]decimal.Decimal, len(cc)-1)
+	minus = make([]decimal.Decimal, len(cc)-1)
+
+	for i := range plus {
+		up := cc[i+1].High.Sub(cc[i].High)
+		down := cc[i].Low.Sub(cc[i+1].Low)
+
+		if up.GreaterThan(down) && up.IsPositive() {
+			plus[i] = up
+		}
+
+		if down.GreaterThan(up) && down.IsPositive() {
+			minus[i] = down
+		}
+	}
+
+	return plus, minus
+}
+
+// calcDirectionalIndicator calculates directional indicator from the
+// smoothed directional movement and true range.
+func calcDirectionalIndicator(dm, tr decimal.Decimal) decimal.Decimal {
+	if tr.IsZero() {
+		return decimal.Zero
+	}
+
+	return dm.Div(tr).Mul(_hundred)
+}
+
+// AroonResult holds both trend values produced by a single Aroon
+// calculation.
+type AroonResult struct {
+	// Uptrend is the Aroon up value.
+	Uptrend decimal.Decimal
+
+	// Downtrend is the Aroon down value.
+	Downtrend decimal.Decimal
+}
+
 // Aroon holds all the necessary information needed to calculate Aroon.
 // The zero value is not usable.
 type Aroon struct {
@@ -59,24 +303,24 @@ func (aroon Aroon) Calc(dd []decimal.Decimal) (
 	}
 
 	minValue := dd[len(dd)-1]
-	minIndex := decimal.NewFromInt(0)
+	minIndex := len(dd) - 1
 	foundMin := false
 
 	maxValue := dd[len(dd)-1]
-	maxIndex := decimal.NewFromInt(0)
+	maxIndex := len(dd) - 1
 	foundMax := false
 
 	for i := len(dd) - 2; i >= 0 && (!foundMin || !foundMax); i-- {
 		if !foundMin && minValue.GreaterThan(dd[i]) {
 			minValue = dd[i]
-			minIndex = decimal.NewFromInt(int64(aroon.length - i))
+			minIndex = i
 		} else if !minValue.Equal(dd[i]) {
 			foundMin = true
 		}
 
 		if !foundMax && maxValue.LessThan(dd[i]) {
 			maxValue = dd[i]
-			maxIndex = decimal.NewFromInt(int64(aroon.length - i))
+			maxIndex = i
 		} else if !maxValue.Equal(dd[i]) {
 			foundMax = true
 		}
@@ -106,9 +350,12 @@ func (aroon Aroon) CalcTrend(dd []decimal.Decimal, trend Trend) (decimal.Decimal
 	return uptrend, nil
 }
 
-func (aroon Aroon) calc(index decimal.Decimal) decimal.Decimal {
-	return decimal.NewFromInt(int64(aroon.length)).Sub(index).
-		Mul(_hundred).Div(decimal.NewFromInt(int64(aroon.length)))
+// calc calculates Aroon value from the position of the extreme value
+// within the window, the most recent data point being at the length-th
+// position.
+func (aroon Aroon) calc(index int) decimal.Decimal {
+	return decimal.NewFromInt(int64(index)).Mul(_hundred).
+		Div(decimal.NewFromInt(int64(aroon.length)))
 }
 
 // Count determines the total amount of data points needed for Aroon
@@ -117,6 +364,53 @@ func (aroon Aroon) Count() int {
 	return aroon.length + 1
 }
 
+// CalcSeries calculates both Aroon trends for every window of Count()
+// data points of the provided slice. The positions of the extreme values
+// are determined for all windows at once, so the calculation takes linear
+// time.
+func (aroon Aroon) CalcSeries(dd []decimal.Decimal) ([]AroonResult, error) {
+	if !aroon.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < aroon.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	lows, highs := rollingReversalIndexes(dd, aroon.Count())
+	res := make([]AroonResult, len(lows))
+
+	for i := range res {
+		res[i] = AroonResult{
+			Uptrend:   aroon.calc(highs[i] - i),
+			Downtrend: aroon.calc(lows[i] - i),
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new Aroon stream that calculates both Aroon trends from
+// the most recent data points each time a new data point is added.
+func (aroon Aroon) Stream() (*Stream[decimal.Decimal, AroonResult], error) {
+	if !aroon.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(aroon.Count(), func(dd []decimal.Decimal) (AroonResult, error) {
+		uptrend, downtrend, err := aroon.Calc(dd)
+		if err != nil {
+			// unlikely to happen
+			return AroonResult{}, err
+		}
+
+		return AroonResult{
+			Uptrend:   uptrend,
+			Downtrend: downtrend,
+		}, nil
+	})
+}
+
 // CCI holds all the necessary information needed to calculate commodity
 // channel index.
 // The zero value is not usable.
@@ -126,25 +420,50 @@ type CCI struct {
 
 	// ma specifies moving average indicator configuration.
 	ma MA
+
+	// factor specifies Lambert's constant which scales mean deviation.
+	// Default value (0.015) is used when it is zero.
+	factor decimal.Decimal
 }
 
 // NewCCI validates provided configuration options and creates
-// new CCI indicator.
-// If provided factor is zero, default value is going to be used (0.015f).
+// new CCI indicator. Default factor (0.015) is used.
 func NewCCI(mat MAType, length int) (CCI, error) {
+	return NewCCIWithFactor(mat, length, decimal.Decimal{})
+}
+
+// NewCCIWithFactor validates provided configuration options and creates
+// new CCI indicator with the provided factor.
+// If provided factor is zero, default value is going to be used (0.015).
+func NewCCIWithFactor(mat MAType, length int, factor decimal.Decimal) (CCI, error) {
 	ma, err := NewMA(mat, length)
 	if err != nil {
 		return CCI{}, err
 	}
 
 	cci := CCI{
-		ma:    ma,
-		valid: true,
+		ma:     ma,
+		factor: factor,
+	}
+
+	if err := cci.validate(); err != nil {
+		return CCI{}, err
 	}
 
 	return cci, nil
 }
 
+// validate checks whether the indicator has valid configuration properties.
+func (cci *CCI) validate() error {
+	if cci.factor.LessThan(decimal.Zero) {
+		return ErrInvalidFactor
+	}
+
+	cci.valid = true
+
+	return nil
+}
+
 // Calc calculates CCI from the provided data points slice.
 // Calculation is based on formula provided by investopedia.
 // https://www.investopedia.com/terms/c/commoditychannelindex.asp.
@@ -163,13 +482,30 @@ func (cci CCI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 		return decimal.Zero, err
 	}
 
-	dnm := decimal.RequireFromString("0.015").Mul(MeanDeviation(dd))
+	return cci.calc(dd, res), nil
+}
+
+// CalcCandles calculates CCI from the provided candles slice. Typical
+// price of each candle is used, as defined by Donald Lambert.
+func (cci CCI) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	return cci.Calc(typicalPrices(cc))
+}
+
+// calc calculates CCI from the provided data points slice and its moving
+// average value.
+func (cci CCI) calc(dd []decimal.Decimal, ma decimal.Decimal) decimal.Decimal {
+	factor := cci.factor
+	if factor.Equal(decimal.Zero) {
+		factor = decimal.RequireFromString("0.015")
+	}
+
+	dnm := factor.Mul(MeanDeviation(dd))
 
 	if dnm.Equal(decimal.Zero) {
-		return decimal.Zero, nil
+		return decimal.Zero
 	}
 
-	return dd[len(dd)-1].Sub(res).Div(dnm), nil
+	return dd[len(dd)-1].Sub(ma).Div(dnm)
 }
 
 // Count determines the total amount of data points needed for CCI
@@ -178,6 +514,63 @@ func (cci CCI) Count() int {
 	return cci.ma.Count()
 }
 
+// CalcSeries calculates CCI for every window of Count() data points of
+// the provided slice. Moving average values are calculated in linear time,
+// however the mean deviation depends on the mean of each window, so it is
+// recalculated for every window and the calculation takes O(n*length) time.
+func (cci CCI) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !cci.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < cci.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res, err := CalcMASeries(cci.ma, dd)
+	if err != nil {
+		return nil, err
+	}
+
+	for i := range res {
+		res[i] = cci.calc(dd[i:i+cci.Count()], res[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new CCI stream that calculates CCI from the most recent
+// data points each time a new data point is added.
+func (cci CCI) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !cci.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(cci.Count(), cci.Calc)
+}
+
+// CalcSeriesCandles calculates CCI for every window of Count() candles of
+// the provided slice. Typical price of each candle is used, so the values
+// are identical to the ones CalcCandles produces.
+func (cci CCI) CalcSeriesCandles(cc []Candle) ([]decimal.Decimal, error) {
+	if !cci.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return cci.CalcSeries(typicalPrices(cc))
+}
+
+// StreamCandles creates new CCI stream that calculates CCI from the
+// typical prices of the most recent candles each time a new candle is
+// added.
+func (cci CCI) StreamCandles() (*Stream[Candle, decimal.Decimal], error) {
+	if !cci.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(cci.Count(), cci.CalcCandles)
+}
+
 // FibonacciLevels holds all the necessary information needed to calculate
 // fibonacci levels.
 // The zero value is not usable.
@@ -256,108 +649,448 @@ func (fl FibonacciLevels) Count() int {
 	return fl.length
 }
 
-// ROC holds all the necessary information needed to calculate rate
-// of change.
-// The zero value is not usable.
-type ROC struct {
-	// valid specifies whether ROC paremeters were validated.
-	valid bool
-
-	// length specifies how many data points should be used
-	// during the calculations.
-	length int
-}
+// CalcSeries calculates fibonacci level for every window of Count() data
+// points of the provided slice. Monotonic deques are used, so the
+// calculation takes linear time.
+func (fl FibonacciLevels) CalcSeries(level decimal.Decimal, dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !fl.valid {
+		return nil, ErrInvalidIndicator
+	}
 
-// NewROC validates provided configuration options and
-// creates new ROC indicator.
-func NewROC(length int) (ROC, error) {
-	roc := ROC{length: length}
+	if level.GreaterThan(decimal.NewFromInt(1)) || level.LessThan(decimal.Zero) {
+		return nil, ErrInvalidLevel
+	}
 
-	if err := roc.validate(); err != nil {
-		return ROC{}, err
+	if len(dd) < fl.Count() {
+		return nil, ErrInvalidDataSize
 	}
 
-	return roc, nil
-}
+	lows, highs := rollingExtremes(dd, fl.Count())
+	res := make([]decimal.Decimal, len(lows))
 
-// validate checks whether the indicator has valid configuration properties.
-func (roc *ROC) validate() error {
-	if roc.length < 1 {
-		return ErrInvalidLength
+	for i := range res {
+		res[i] = lows[i].Add(highs[i].Sub(lows[i]).Mul(level))
 	}
 
-	roc.valid = true
-
-	return nil
+	return res, nil
 }
 
-// Calc calculates ROC from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/p/pricerateofchange.asp.
-func (roc ROC) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !roc.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// Stream creates new FibonacciLevels stream that calculates the specified
+// fibonacci level from the most recent data points each time a new data
+// point is added.
+func (fl FibonacciLevels) Stream(level decimal.Decimal) (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !fl.valid {
+		return nil, ErrInvalidIndicator
 	}
 
-	if len(dd) != roc.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	if level.GreaterThan(decimal.NewFromInt(1)) || level.LessThan(decimal.Zero) {
+		return nil, ErrInvalidLevel
 	}
 
-	curr := dd[0]
-	last := dd[len(dd)-1]
-
-	return curr.Div(last).Sub(_one).Mul(_hundred), nil
+	return NewStream(fl.Count(), func(dd []decimal.Decimal) (decimal.Decimal, error) {
+		return fl.Calc(level, dd)
+	})
 }
 
-// Count determines the total amount of data points needed for ROC
-// calculation.
-func (roc ROC) Count() int {
-	return roc.length
+// MACDResult holds all values produced by a single MACD calculation.
+type MACDResult struct {
+	// MACD is the MACD line value.
+	MACD decimal.Decimal
+
+	// Signal is the signal line value.
+	Signal decimal.Decimal
+
+	// Histogram is the difference between MACD and signal line values.
+	Histogram decimal.Decimal
 }
 
-// RSI holds all the necessary information needed to calculate relative
-// strength index.
+// MACD holds all the necessary information needed to calculate moving
+// average convergence divergence.
 // The zero value is not usable.
-type RSI struct {
-	// valid specifies whether RSI paremeters were validated.
+type MACD struct {
+	// valid specifies whether MACD paremeters were validated.
 	valid bool
 
-	// length specifies how many data points should be used
-	// during the calculations.
-	length int
+	// fast specifies fast MA indicator configuration.
+	fast MA
+
+	// slow specifies slow MA indicator configuration.
+	slow MA
+
+	// signal specifies signal line MA indicator configuration.
+	signal MA
 }
 
-// NewRSI validates provided configuration options and
-// creates new RSI indicator.
-func NewRSI(length int) (RSI, error) {
-	rsi := RSI{
-		length: length,
+// NewMACD validates provided configuration options and creates new
+// MACD indicator. The same moving average type is used for fast, slow
+// and signal lines.
+func NewMACD(fast, slow, signal int, mat MAType) (MACD, error) {
+	fastMA, err := NewMA(mat, fast)
+	if err != nil {
+		return MACD{}, err
 	}
 
-	if err := rsi.validate(); err != nil {
-		return RSI{}, err
+	slowMA, err := NewMA(mat, slow)
+	if err != nil {
+		return MACD{}, err
 	}
 
-	return rsi, nil
+	signalMA, err := NewMA(mat, signal)
+	if err != nil {
+		return MACD{}, err
+	}
+
+	macd := MACD{
+		fast:   fastMA,
+		slow:   slowMA,
+		signal: signalMA,
+	}
+
+	if err := macd.validate(); err != nil {
+		return MACD{}, err
+	}
+
+	return macd, nil
 }
 
 // validate checks whether the indicator has valid configuration properties.
-func (rsi *RSI) validate() error {
-	if rsi.length < 1 {
+func (macd *MACD) validate() error {
+	if macd.fast.Count() >= macd.slow.Count() {
 		return ErrInvalidLength
 	}
 
-	rsi.valid = true
+	macd.valid = true
 
 	return nil
 }
 
-// Calc calculates RSI from the provided data points slice.
+// Calc calculates all MACD values from the provided data points slice.
 // Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/r/rsi.asp.
-// All credits are due to J. Welles Wilder Jr. who developed RSI indicator.
-func (rsi RSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !rsi.valid {
+// https://www.investopedia.com/terms/m/macd.asp.
+// All credits are due to Gerald Appel who developed MACD indicator.
+func (macd MACD) Calc(dd []decimal.Decimal) (
+	line decimal.Decimal,
+	signal decimal.Decimal,
+	histogram decimal.Decimal,
+	err error,
+) {
+
+	if !macd.valid {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != macd.Count() {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := macd.calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, decimal.Zero, err
+	}
+
+	return res[0].MACD, res[0].Signal, res[0].Histogram, nil
+}
+
+// CalcOutput calculates specified MACD value from the provided data
+// points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/m/macd.asp.
+// All credits are due to Gerald Appel who developed MACD indicator.
+func (macd MACD) CalcOutput(dd []decimal.Decimal, output MACDOutput) (decimal.Decimal, error) {
+	if err := output.Validate(); err != nil {
+		return decimal.Zero, err
+	}
+
+	line, signal, histogram, err := macd.Calc(dd)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	switch output {
+	case MACDOutputLine:
+		return line, nil
+	case MACDOutputSignal:
+		return signal, nil
+	default: // output is validated, only MACDOutputHistogram is left.
+		return histogram, nil
+	}
+}
+
+// calc calculates MACD values for every window of Count() data points of
+// the provided slice.
+func (macd MACD) calc(dd []decimal.Decimal) ([]MACDResult, error) {
+	fast, err := CalcMASeries(macd.fast, dd)
+	if err != nil {
+		return nil, err
+	}
+
+	slow, err := CalcMASeries(macd.slow, dd)
+	if err != nil {
+		return nil, err
+	}
+
+	lines := make([]decimal.Decimal, len(slow))
+
+	for i := range lines {
+		lines[i] = fast[len(fast)-len(slow)+i].Sub(slow[i])
+	}
+
+	signals, err := CalcMASeries(macd.signal, lines)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]MACDResult, len(signals))
+
+	for i := range res {
+		line := lines[len(lines)-len(signals)+i]
+
+		res[i] = MACDResult{
+			MACD:      line,
+			Signal:    signals[i],
+			Histogram: line.Sub(signals[i]),
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for MACD
+// calculation, including the warm-up of the signal line.
+func (macd MACD) Count() int {
+	return macd.slow.Count() + macd.signal.Count() - 1
+}
+
+// CalcSeries calculates all MACD values for every window of Count() data
+// points of the provided slice. Every moving average value is calculated
+// from its own window, so the values are identical to the ones Calc
+// produces.
+func (macd MACD) CalcSeries(dd []decimal.Decimal) ([]MACDResult, error) {
+	if !macd.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < macd.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	return macd.calc(dd)
+}
+
+// Stream creates new MACD stream that calculates all MACD values from the
+// most recent data points each time a new data point is added.
+func (macd MACD) Stream() (*Stream[decimal.Decimal, MACDResult], error) {
+	if !macd.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(macd.Count(), func(dd []decimal.Decimal) (MACDResult, error) {
+		line, signal, histogram, err := macd.Calc(dd)
+		if err != nil {
+			// unlikely to happen
+			return MACDResult{}, err
+		}
+
+		return MACDResult{
+			MACD:      line,
+			Signal:    signal,
+			Histogram: histogram,
+		}, nil
+	})
+}
+
+// ROC holds all the necessary information needed to calculate rate
+// of change.
+// The zero value is not usable.
+type ROC struct {
+	// valid specifies whether ROC paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewROC validates provided configuration options and
+// creates new ROC indicator.
+func NewROC(length int) (ROC, error) {
+	roc := ROC{length: length}
+
+	if err := roc.validate(); err != nil {
+		return ROC{}, err
+	}
+
+	return roc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (roc *ROC) validate() error {
+	if roc.length < 1 {
+		return ErrInvalidLength
+	}
+
+	roc.valid = true
+
+	return nil
+}
+
+// Calc calculates ROC from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/p/pricerateofchange.asp.
+func (roc ROC) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !roc.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != roc.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	curr := dd[0]
+	last := dd[len(dd)-1]
+
+	return curr.Div(last).Sub(_one).Mul(_hundred), nil
+}
+
+// Count determines the total amount of data points needed for ROC
+// calculation.
+func (roc ROC) Count() int {
+	return roc.length
+}
+
+// CalcSeries calculates ROC for every window of Count() data points of
+// the provided slice.
+func (roc ROC) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !roc.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < roc.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, len(dd)-roc.Count()+1)
+
+	for i := range res {
+		res[i] = dd[i].Div(dd[i+roc.length-1]).Sub(_one).Mul(_hundred)
+	}
+
+	return res, nil
+}
+
+// Stream creates new ROC stream that calculates ROC from the most recent
+// data points each time a new data point is added.
+func (roc ROC) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !roc.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(roc.Count(), roc.Calc)
+}
+
+// RSI holds all the necessary information needed to calculate relative
+// strength index.
+// The zero value is not usable.
+type RSI struct {
+	// valid specifies whether RSI paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// ma specifies MA indicator configuration used to smooth average
+	// gains and losses. Simple averages over a single window are used
+	// when it is nil.
+	ma MA
+}
+
+// NewRSI validates provided configuration options and
+// creates new RSI indicator.
+func NewRSI(length int) (RSI, error) {
+	return NewRSIWithSmoothing(length, RSISmoothingSimple)
+}
+
+// NewRSIWithSmoothing validates provided configuration options and
+// creates new RSI indicator that smooths average gains and losses.
+// RSISmoothingSimple averages gains and losses over a single window,
+// RSISmoothingWilder and RSISmoothingExponential smooth them with SMMA
+// and EMA respectively.
+// Smoothed RSI requires 2*length data points, since every window seeds the
+// moving average with the simple average of its first length changes and
+// smooths only the remaining ones. TradingView, TA-Lib and most other
+// charting platforms smooth the whole history continuously instead, so
+// their values are affected by older data points as well and differ from
+// the ones calculated here, especially right after sharp price changes.
+func NewRSIWithSmoothing(length int, smoothing RSISmoothing) (RSI, error) {
+	rsi := RSI{
+		length: length,
+	}
+
+	if err := rsi.validate(); err != nil {
+		return RSI{}, err
+	}
+
+	var err error
+
+	switch smoothing {
+	case RSISmoothingSimple:
+		// simple averages don't need any moving average.
+	case RSISmoothingWilder:
+		rsi.ma, err = NewSMMA(length)
+	case RSISmoothingExponential:
+		rsi.ma, err = NewEMA(length)
+	default:
+		return RSI{}, ErrInvalidRSISmoothing
+	}
+
+	if err != nil {
+		// unlikely to happen
+		return RSI{}, err
+	}
+
+	return rsi, nil
+}
+
+// NewRSIWithMA validates provided configuration options and creates new
+// RSI indicator that smooths average gains and losses with the provided
+// moving average type, e.g. one registered with RegisterMA.
+func NewRSIWithMA(mat MAType, length int) (RSI, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return RSI{}, err
+	}
+
+	rsi := RSI{
+		length: length,
+		ma:     ma,
+	}
+
+	if err := rsi.validate(); err != nil {
+		return RSI{}, err
+	}
+
+	return rsi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (rsi *RSI) validate() error {
+	if rsi.length < 1 {
+		return ErrInvalidLength
+	}
+
+	rsi.valid = true
+
+	return nil
+}
+
+// Calc calculates RSI from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/r/rsi.asp.
+// All credits are due to J. Welles Wilder Jr. who developed RSI indicator.
+func (rsi RSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !rsi.valid {
 		return decimal.Zero, ErrInvalidIndicator
 	}
 
@@ -365,39 +1098,208 @@ func (rsi RSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	ag := decimal.Zero
-	al := decimal.Zero
-	length := decimal.NewFromInt(int64(rsi.length))
+	if rsi.ma != nil {
+		gg, ll := rsiChanges(dd)
+
+		ag, err := rsi.ma.Calc(gg)
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+
+		al, err := rsi.ma.Calc(ll)
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+
+		return rsi.calcSmoothed(ag, al), nil
+	}
+
+	var (
+		ag, al        decimal.Decimal
+		gains, losses int
+	)
 
 	for i := 1; i < len(dd); i++ {
 		if dd[i].Sub(dd[i-1]).LessThan(decimal.Zero) {
 			al = al.Add(dd[i].Sub(dd[i-1]).Abs())
+			losses++
 		} else {
 			ag = ag.Add(dd[i].Sub(dd[i-1]))
+			gains++
 		}
 	}
 
-	if ag == decimal.Zero {
-		return decimal.NewFromInt(0), nil
+	return rsi.calc(ag, al, gains, losses), nil
+}
+
+// calc calculates RSI from the total gain and loss of the data points and
+// the number of changes that contributed to them. Unchanged data points
+// are counted as gains.
+func (rsi RSI) calc(ag, al decimal.Decimal, gains, losses int) decimal.Decimal {
+	if gains == 0 {
+		return decimal.NewFromInt(0)
 	}
 
-	if al == decimal.Zero {
-		return _hundred, nil
+	if losses == 0 {
+		return _hundred
 	}
 
+	length := decimal.NewFromInt(int64(rsi.length))
+
 	ag = ag.Div(length)
 
 	al = al.Div(length)
 
-	return _hundred.Sub(_hundred.Div(decimal.NewFromInt(1).Add(ag.Div(al)))), nil
+	return _hundred.Sub(_hundred.Div(decimal.NewFromInt(1).Add(ag.Div(al))))
+}
+
+// calcSmoothed calculates RSI from the smoothed average gain and loss.
+func (rsi RSI) calcSmoothed(ag, al decimal.Decimal) decimal.Decimal {
+	if al.IsZero() {
+		return _hundred
+	}
+
+	if ag.IsZero() {
+		return decimal.NewFromInt(0)
+	}
+
+	return _hundred.Sub(_hundred.Div(decimal.NewFromInt(1).Add(ag.Div(al))))
 }
 
 // Count determines the total amount of data points needed for RSI
-// calculation.
+// calculation. Smoothed RSI includes the data points needed for the
+// moving average warm-up.
 func (rsi RSI) Count() int {
+	if rsi.ma != nil {
+		return rsi.ma.Count() + 1
+	}
+
 	return rsi.length
 }
 
+// CalcSeries calculates RSI for every window of Count() data points of
+// the provided slice. Rolling sums of gains and losses are used, so the
+// calculation takes linear time.
+func (rsi RSI) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !rsi.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < rsi.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	if rsi.ma != nil {
+		return rsi.calcSmoothedSeries(dd)
+	}
+
+	res := make([]decimal.Decimal, len(dd)-rsi.Count()+1)
+
+	var (
+		ag, al        decimal.Decimal
+		gains, losses int
+	)
+
+	for i := range dd {
+		if i > 0 {
+			if chg := dd[i].Sub(dd[i-1]); chg.LessThan(decimal.Zero) {
+				al = al.Add(chg.Abs())
+				losses++
+			} else {
+				ag = ag.Add(chg)
+				gains++
+			}
+		}
+
+		// The change that is no longer part of the window is removed.
+		if j := i - rsi.length + 1; j > 0 {
+			if chg := dd[j].Sub(dd[j-1]); chg.LessThan(decimal.Zero) {
+				al = al.Sub(chg.Abs())
+				losses--
+			} else {
+				ag = ag.Sub(chg)
+				gains--
+			}
+		}
+
+		if i >= rsi.length-1 {
+			res[i-rsi.length+1] = rsi.calc(ag, al, gains, losses)
+		}
+	}
+
+	return res, nil
+}
+
+// calcSmoothedSeries calculates smoothed RSI for every window of Count()
+// data points of the provided slice.
+func (rsi RSI) calcSmoothedSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	gg, ll := rsiChanges(dd)
+
+	ag, err := CalcMASeries(rsi.ma, gg)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	al, err := CalcMASeries(rsi.ma, ll)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	res := make([]decimal.Decimal, len(ag))
+
+	for i := range res {
+		res[i] = rsi.calcSmoothed(ag[i], al[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new RSI stream that calculates RSI from the most recent
+// data points each time a new data point is added.
+func (rsi RSI) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !rsi.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(rsi.Count(), rsi.Calc)
+}
+
+// rsiChanges splits changes between consecutive data points into gains
+// and losses. Both slices contain a zero when the change doesn't belong
+// to them.
+func rsiChanges(dd []decimal.Decimal) (gains, losses []decimal.Decimal) {
+	if len(dd) < 2 {
+		return nil, nil
+	}
+
+	gains = make([]decimal.Decimal, len(dd)-1)
+	losses = make([]decimal.Decimal, len(dd)-1)
+
+	for i := range gains {
+		if chg := dd[i+1].Sub(dd[i]); chg.LessThan(decimal.Zero) {
+			losses[i] = chg.Abs()
+		} else {
+			gains[i] = chg
+		}
+	}
+
+	return gains, losses
+}
+
+// StochRSIResult holds both lines produced by a single StochRSI
+// calculation.
+type StochRSIResult struct {
+	// K is the smoothed %K line value.
+	K decimal.Decimal
+
+	// D is the %D signal line value.
+	D decimal.Decimal
+}
+
 // StochRSI holds all the necessary information needed to calculate stoch
 // relative strength index.
 // The zero value is not usable.
@@ -407,23 +1309,97 @@ type StochRSI struct {
 
 	// rsi specifies the base relative strength index.
 	rsi RSI
+
+	// length specifies how many RSI values should be used to find
+	// the lowest and the highest RSI.
+	length int
+
+	// k specifies MA indicator configuration used to smooth %K line.
+	k MA
+
+	// d specifies MA indicator configuration used to calculate %D line.
+	d MA
 }
 
 // NewStochRSI validates provided configuration options and
-// creates new StochRSI indicator.
+// creates new StochRSI indicator. Simple RSI of the given length is
+// used and the same amount of RSI values is compared. Neither %K nor %D
+// lines are smoothed, NewStochRSIWithLines should be used for them.
 func NewStochRSI(length int) (StochRSI, error) {
 	rsi, err := NewRSI(length)
 	if err != nil {
 		return StochRSI{}, err
 	}
 
-	return StochRSI{
-		valid: true,
-		rsi:   rsi,
-	}, nil
+	line, err := NewSMA(1)
+	if err != nil {
+		// unlikely to happen
+		return StochRSI{}, err
+	}
+
+	s := StochRSI{
+		rsi:    rsi,
+		length: length,
+		k:      line,
+		d:      line,
+	}
+
+	if err := s.validate(); err != nil {
+		return StochRSI{}, err
+	}
+
+	return s, nil
+}
+
+// NewStochRSIWithLines validates provided configuration options and
+// creates new StochRSI indicator that smooths %K and %D lines, which are
+// calculated by CalcLines. Wilder smoothed RSI is used and the same moving
+// average type is used for %K smoothing and %D line. Most charting
+// platforms use 14, 14, 3, 3 and MATypeSimple by default.
+func NewStochRSIWithLines(rsiLength, stochLength, kSmoothing, dLength int, mat MAType) (StochRSI, error) {
+	rsi, err := NewRSIWithSmoothing(rsiLength, RSISmoothingWilder)
+	if err != nil {
+		return StochRSI{}, err
+	}
+
+	k, err := NewMA(mat, kSmoothing)
+	if err != nil {
+		return StochRSI{}, err
+	}
+
+	d, err := NewMA(mat, dLength)
+	if err != nil {
+		return StochRSI{}, err
+	}
+
+	s := StochRSI{
+		rsi:    rsi,
+		length: stochLength,
+		k:      k,
+		d:      d,
+	}
+
+	if err := s.validate(); err != nil {
+		return StochRSI{}, err
+	}
+
+	return s, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (s *StochRSI) validate() error {
+	if s.length < 1 {
+		return ErrInvalidLength
+	}
+
+	s.valid = true
+
+	return nil
 }
 
-// Calc calculates StochRSI from the provided data slice.
+// Calc calculates StochRSI from the provided data slice. The latest RSI
+// value is compared to the lowest and the highest RSI values, the result
+// is in range from 0 to 1.
 // Calculation is based on formula provided by investopedia.
 // https://www.investopedia.com/terms/s/stochrsi.asp.
 func (s StochRSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
@@ -435,42 +1411,184 @@ func (s StochRSI) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 		return decimal.Zero, ErrInvalidDataSize
 	}
 
-	res := make([]decimal.Decimal, s.rsi.length)
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
 
-	var err error
-	for i := 0; i < s.rsi.length; i++ {
-		res[i], err = s.rsi.Calc(dd[i : s.rsi.length+i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
+	return s.calcValues(rr)[0], nil
+}
+
+// CalcLines calculates both %K and %D lines from the provided data slice.
+// Values calculated by Calc for every window of the data slice are smoothed
+// into %K line, which is then smoothed again into %D line. Both lines are
+// in range from 0 to 100, as on most charting platforms.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/stochrsi.asp.
+func (s StochRSI) CalcLines(dd []decimal.Decimal) (k, d decimal.Decimal, err error) {
+	if !s.valid {
+		return decimal.Zero, decimal.Zero, ErrInvalidIndicator
 	}
 
-	curr := res[0]
-	maxValue := res[0]
-	minValue := res[0]
+	if len(dd) != s.CountLines() {
+		return decimal.Zero, decimal.Zero, ErrInvalidDataSize
+	}
 
-	for i := 1; i < len(res); i++ {
-		if maxValue.LessThan(res[i]) {
-			maxValue = res[i]
-		}
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, err
+	}
 
-		if minValue.GreaterThan(res[i]) {
-			minValue = res[i]
-		}
+	res, err := s.calcLines(rr)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, err
 	}
 
+	return res[0].K, res[0].D, nil
+}
+
+// calc calculates StochRSI from the current, the lowest and the highest
+// RSI values.
+func (s StochRSI) calc(curr, minValue, maxValue decimal.Decimal) decimal.Decimal {
 	if maxValue.Equal(minValue) {
-		return decimal.Zero, nil
+		return decimal.Zero
+	}
+
+	return curr.Sub(minValue).Div(maxValue.Sub(minValue))
+}
+
+// calcValues calculates StochRSI for every window of the provided RSI
+// values.
+func (s StochRSI) calcValues(rr []decimal.Decimal) []decimal.Decimal {
+	lows, highs := rollingExtremes(rr, s.length)
+	res := make([]decimal.Decimal, len(lows))
+
+	for i := range res {
+		res[i] = s.calc(rr[i+s.length-1], lows[i], highs[i])
+	}
+
+	return res
+}
+
+// calcLines calculates both lines for every window of the provided RSI
+// values.
+func (s StochRSI) calcLines(rr []decimal.Decimal) ([]StochRSIResult, error) {
+	raw := s.calcValues(rr)
+
+	for i := range raw {
+		raw[i] = raw[i].Mul(_hundred)
+	}
+
+	kk, err := CalcMASeries(s.k, raw)
+	if err != nil {
+		return nil, err
 	}
 
-	return curr.Sub(minValue).Div(maxValue.Sub(minValue)), nil
+	dd, err := CalcMASeries(s.d, kk)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]StochRSIResult, len(dd))
+
+	for i := range res {
+		res[i] = StochRSIResult{
+			K: kk[len(kk)-len(dd)+i],
+			D: dd[i],
+		}
+	}
+
+	return res, nil
 }
 
 // Count determines the total amount of data needed for StochRSI
 // calculation.
 func (s StochRSI) Count() int {
-	return s.rsi.length*2 - 1
+	return s.rsi.Count() + s.length - 1
+}
+
+// CountLines determines the total amount of data needed for the
+// calculation of both lines, including their warm-up.
+func (s StochRSI) CountLines() int {
+	return s.Count() + s.k.Count() + s.d.Count() - 2
+}
+
+// CalcSeries calculates StochRSI for every window of Count() data points
+// of the provided slice. RSI values are calculated once for the whole
+// series and shared by the windows, while the values remain identical to
+// the ones Calc produces.
+func (s StochRSI) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !s.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < s.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return s.calcValues(rr), nil
+}
+
+// CalcSeriesLines calculates both %K and %D lines for every window of
+// CountLines() data points of the provided slice. The values are identical
+// to the ones CalcLines produces.
+func (s StochRSI) CalcSeriesLines(dd []decimal.Decimal) ([]StochRSIResult, error) {
+	if !s.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < s.CountLines() {
+		return nil, ErrInvalidDataSize
+	}
+
+	rr, err := s.rsi.CalcSeries(dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return s.calcLines(rr)
+}
+
+// Stream creates new StochRSI stream that calculates StochRSI from the
+// most recent data points each time a new data point is added.
+func (s StochRSI) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !s.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(s.Count(), s.Calc)
+}
+
+// StreamLines creates new StochRSI stream that calculates both %K and %D
+// lines from the most recent data points each time a new data point is
+// added.
+func (s StochRSI) StreamLines() (*Stream[decimal.Decimal, StochRSIResult], error) {
+	if !s.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(s.CountLines(), func(dd []decimal.Decimal) (StochRSIResult, error) {
+		k, d, err := s.CalcLines(dd)
+		if err != nil {
+			// unlikely to happen
+			return StochRSIResult{}, err
+		}
+
+		return StochRSIResult{
+			K: k,
+			D: d,
+		}, nil
+	})
 }
 
 // Stoch holds all the necessary information needed to calculate stochastic
@@ -535,12 +1653,18 @@ func (stoch Stoch) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 		}
 	}
 
+	return stoch.calc(dd[len(dd)-1], low, high), nil
+}
+
+// calc calculates Stoch from the last, the lowest and the highest data
+// point values.
+func (stoch Stoch) calc(last, low, high decimal.Decimal) decimal.Decimal {
 	dnm := high.Sub(low)
 	if dnm.Equal(decimal.Zero) {
-		return decimal.Zero, nil
+		return decimal.Zero
 	}
 
-	return dd[len(dd)-1].Sub(low).Div(dnm).Mul(_hundred), nil
+	return last.Sub(low).Div(dnm).Mul(_hundred)
 }
 
 // Count determines the total amount of data points needed for Stoch
@@ -548,3 +1672,197 @@ func (stoch Stoch) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 func (stoch Stoch) Count() int {
 	return stoch.length
 }
+
+// CalcSeries calculates Stoch for every window of Count() data points of
+// the provided slice. Monotonic deques are used, so the calculation takes
+// linear time.
+func (stoch Stoch) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !stoch.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < stoch.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	lows, highs := rollingExtremes(dd, stoch.length)
+	res := make([]decimal.Decimal, len(lows))
+
+	for i := range res {
+		res[i] = stoch.calc(dd[i+stoch.length-1], lows[i], highs[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new Stoch stream that calculates Stoch from the most recent
+// data points each time a new data point is added.
+func (stoch Stoch) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !stoch.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(stoch.Count(), stoch.Calc)
+}
+
+// FullStochResult holds both lines produced by a single FullStoch
+// calculation.
+type FullStochResult struct {
+	// K is the smoothed %K line value.
+	K decimal.Decimal
+
+	// D is the %D signal line value.
+	D decimal.Decimal
+}
+
+// FullStoch holds all the necessary information needed to calculate full
+// stochastic oscillator from candles.
+// The zero value is not usable.
+type FullStoch struct {
+	// valid specifies whether FullStoch paremeters were validated.
+	valid bool
+
+	// stoch specifies raw %K indicator configuration.
+	stoch Stoch
+
+	// k specifies MA indicator configuration used to smooth %K line.
+	k MA
+
+	// d specifies MA indicator configuration used to calculate %D line.
+	d MA
+}
+
+// NewFullStoch validates provided configuration options and creates new
+// FullStoch indicator. The same moving average type is used for %K
+// smoothing and %D line. Fast stochastic oscillator can be calculated
+// by setting kSmoothing to 1.
+func NewFullStoch(length, kSmoothing, dLength int, mat MAType) (FullStoch, error) {
+	stoch, err := NewStoch(length)
+	if err != nil {
+		return FullStoch{}, err
+	}
+
+	k, err := NewMA(mat, kSmoothing)
+	if err != nil {
+		return FullStoch{}, err
+	}
+
+	d, err := NewMA(mat, dLength)
+	if err != nil {
+		return FullStoch{}, err
+	}
+
+	return FullStoch{
+		valid: true,
+		stoch: stoch,
+		k:     k,
+		d:     d,
+	}, nil
+}
+
+// Calc calculates both %K and %D lines from the provided candles slice.
+// The highest high and the lowest low of the candles are used.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/stochasticoscillator.asp.
+// All credits are due to George Lane who developed stochastic oscillator.
+func (fs FullStoch) Calc(cc []Candle) (k, d decimal.Decimal, err error) {
+	if !fs.valid {
+		return decimal.Zero, decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != fs.Count() {
+		return decimal.Zero, decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := fs.calc(cc)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	return res[0].K, res[0].D, nil
+}
+
+// calc calculates both lines for every window of Count() candles of the
+// provided slice.
+func (fs FullStoch) calc(cc []Candle) ([]FullStochResult, error) {
+	lows := make([]decimal.Decimal, len(cc))
+	highs := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		lows[i] = cc[i].Low
+		highs[i] = cc[i].High
+	}
+
+	lows, _ = rollingExtremes(lows, fs.stoch.length)
+	_, highs = rollingExtremes(highs, fs.stoch.length)
+
+	raw := make([]decimal.Decimal, len(lows))
+
+	for i := range raw {
+		raw[i] = fs.stoch.calc(cc[i+fs.stoch.length-1].Close, lows[i], highs[i])
+	}
+
+	kk, err := CalcMASeries(fs.k, raw)
+	if err != nil {
+		return nil, err
+	}
+
+	dd, err := CalcMASeries(fs.d, kk)
+	if err != nil {
+		return nil, err
+	}
+
+	res := make([]FullStochResult, len(dd))
+
+	for i := range res {
+		res[i] = FullStochResult{
+			K: kk[len(kk)-len(dd)+i],
+			D: dd[i],
+		}
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for FullStoch
+// calculation, including the warm-up of both lines.
+func (fs FullStoch) Count() int {
+	return fs.stoch.Count() + fs.k.Count() + fs.d.Count() - 2
+}
+
+// CalcSeries calculates both %K and %D lines for every window of Count()
+// candles of the provided slice. The values are identical to the ones Calc
+// produces.
+func (fs FullStoch) CalcSeries(cc []Candle) ([]FullStochResult, error) {
+	if !fs.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < fs.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	return fs.calc(cc)
+}
+
+// Stream creates new FullStoch stream that calculates both %K and %D
+// lines from the most recent candles each time a new candle is added.
+func (fs FullStoch) Stream() (*Stream[Candle, FullStochResult], error) {
+	if !fs.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(fs.Count(), func(cc []Candle) (FullStochResult, error) {
+		k, d, err := fs.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return FullStochResult{}, err
+		}
+
+		return FullStochResult{
+			K: k,
+			D: d,
+		}, nil
+	})
+}
@@ -7,6 +7,248 @@ import (
 	"github.com/stretchr/testify/assert"
 )
 
+func Test_NewADX(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ADX
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new ADX": {
+			Length: 3,
+			Result: ADX{
+				valid: true,
+				smma: SMMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 3,
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewADX(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ADX_Calc(t *testing.T) {
+	flat := make([]Candle, 6)
+	for i := range flat {
+		flat[i] = Candle{
+			High:  decimal.NewFromInt(10),
+			Low:   decimal.NewFromInt(10),
+			Close: decimal.NewFromInt(10),
+		}
+	}
+
+	cc := map[string]struct {
+		ADX     ADX
+		Data    []Candle
+		ADXV    decimal.Decimal
+		PlusDI  decimal.Decimal
+		MinusDI decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ADX: ADX{
+				valid: true,
+				smma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 2},
+				},
+			},
+			Data:  candleTestData()[:5],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation with unchanged prices": {
+			ADX: ADX{
+				valid: true,
+				smma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 2},
+				},
+			},
+			Data: flat,
+		},
+		"Successful calculation with length 2": {
+			ADX: ADX{
+				valid: true,
+				smma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 2},
+				},
+			},
+			Data:    candleTestData()[:6],
+			ADXV:    decimal.RequireFromString("72.88765802"),
+			PlusDI:  decimal.RequireFromString("5.54414784"),
+			MinusDI: decimal.RequireFromString("27.65229295"),
+		},
+		"Successful calculation with length 3": {
+			ADX: ADX{
+				valid: true,
+				smma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 3},
+				},
+			},
+			Data:    candleTestData()[:10],
+			ADXV:    decimal.RequireFromString("55.21730543"),
+			PlusDI:  decimal.RequireFromString("11.60842963"),
+			MinusDI: decimal.RequireFromString("23.36507416"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			adxv, plusDI, minusDI, err := c.ADX.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.ADXV.String(), adxv.Round(8).String())
+			assert.Equal(t, c.PlusDI.String(), plusDI.Round(8).String())
+			assert.Equal(t, c.MinusDI.String(), minusDI.Round(8).String())
+		})
+	}
+}
+
+func Test_ADX_CalcTrend(t *testing.T) {
+	adx := ADX{
+		valid: true,
+		smma: SMMA{
+			valid: true,
+			sma:   SMA{valid: true, length: 2},
+		},
+	}
+
+	cc := map[string]struct {
+		ADX    ADX
+		Data   []Candle
+		Trend  Trend
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid trend": {
+			ADX:   adx,
+			Data:  candleTestData()[:6],
+			Error: ErrInvalidTrend,
+		},
+		"Invalid indicator": {
+			Trend: TrendUp,
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with TrendUp": {
+			ADX:    adx,
+			Data:   candleTestData()[:6],
+			Trend:  TrendUp,
+			Result: decimal.RequireFromString("5.54414784"),
+		},
+		"Successful calculation with TrendDown": {
+			ADX:    adx,
+			Data:   candleTestData()[:6],
+			Trend:  TrendDown,
+			Result: decimal.RequireFromString("27.65229295"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ADX.CalcTrend(c.Data, c.Trend)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ADX_Count(t *testing.T) {
+	assert.Equal(t, 10, ADX{smma: SMMA{sma: SMA{length: 3}}}.Count())
+}
+
+func Test_ADX_CalcSeries(t *testing.T) {
+	_, err := ADX{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	adx := ADX{valid: true, smma: SMMA{valid: true, sma: SMA{valid: true, length: 3}}}
+
+	_, err = adx.CalcSeries(candleTestData()[:9])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	cc := candleTestData()
+
+	res, err := adx.CalcSeries(cc)
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, adx.Count(), func(cc []Candle) (ADXResult, error) {
+		adxv, plusDI, minusDI, err := adx.Calc(cc)
+
+		return ADXResult{ADX: adxv, PlusDI: plusDI, MinusDI: minusDI}, err
+	}, cc)
+}
+
+func Test_ADX_Stream(t *testing.T) {
+	_, err := ADX{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	adx := ADX{valid: true, smma: SMMA{valid: true, sma: SMA{valid: true, length: 2}}}
+
+	s, err := adx.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, adx.Count(), func(cc []Candle) (ADXResult, error) {
+		adxv, plusDI, minusDI, err := adx.Calc(cc)
+
+		return ADXResult{
+			ADX:     adxv,
+			PlusDI:  plusDI,
+			MinusDI: minusDI,
+		}, err
+	}, candleTestData())
+}
+
+func Test_directionalMovements(t *testing.T) {
+	plus, minus := directionalMovements(candleTestData()[:1])
+	assert.Nil(t, plus)
+	assert.Nil(t, minus)
+
+	plus, minus = directionalMovements([]Candle{
+		{High: decimal.NewFromInt(10), Low: decimal.NewFromInt(8)},
+		{High: decimal.NewFromInt(12), Low: decimal.NewFromInt(9)},
+		{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(6)},
+		{High: decimal.NewFromInt(13), Low: decimal.NewFromInt(4)},
+	})
+
+	assert.Equal(t, "2", plus[0].String())
+	assert.Equal(t, "0", plus[1].String())
+	assert.Equal(t, "0", plus[2].String())
+	assert.Equal(t, "0", minus[0].String())
+	assert.Equal(t, "3", minus[1].String())
+	assert.Equal(t, "0", minus[2].String())
+}
+
 func Test_NewAroon(t *testing.T) {
 	cc := map[string]struct {
 		Length int
@@ -109,6 +351,20 @@ func Test_Aroon_Calc(t *testing.T) {
 			UpResult:   decimal.NewFromInt(40),
 			DownResult: _hundred,
 		},
+		"Successful calculation stopping at the first reversals": {
+			Aroon: Aroon{
+				valid:  true,
+				length: 3,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(10),
+				decimal.NewFromInt(50),
+				decimal.NewFromInt(20),
+				decimal.NewFromInt(30),
+			},
+			UpResult:   _hundred,
+			DownResult: decimal.RequireFromString("66.6666666666666667"),
+		},
 	}
 
 	for cn, c := range cc {
@@ -240,6 +496,52 @@ func Test_Aroon_Count(t *testing.T) {
 	}.Count())
 }
 
+func Test_Aroon_Stream(t *testing.T) {
+	_, err := Aroon{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	aroon := Aroon{valid: true, length: 5}
+
+	s, err := aroon.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, aroon.Count(), func(dd []decimal.Decimal) (AroonResult, error) {
+		uptrend, downtrend, err := aroon.Calc(dd)
+
+		return AroonResult{Uptrend: uptrend, Downtrend: downtrend}, err
+	}, streamTestData())
+}
+
+func Test_Aroon_CalcSeries(t *testing.T) {
+	_, err := Aroon{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	aroon := Aroon{valid: true, length: 5}
+
+	_, err = aroon.CalcSeries(streamTestData()[:5])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := aroon.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, aroon.Count(), func(dd []decimal.Decimal) (AroonResult, error) {
+		uptrend, downtrend, err := aroon.Calc(dd)
+
+		return AroonResult{Uptrend: uptrend, Downtrend: downtrend}, err
+	}, streamTestData())
+
+	var flat []decimal.Decimal
+	for _, v := range []int64{3, 3, 1, 1, 4, 4, 4, 2, 2, 5, 5, 1, 3, 3, 3} {
+		flat = append(flat, decimal.NewFromInt(v))
+	}
+
+	res, err = aroon.CalcSeries(flat)
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, aroon.Count(), func(dd []decimal.Decimal) (AroonResult, error) {
+		uptrend, downtrend, err := aroon.Calc(dd)
+
+		return AroonResult{Uptrend: uptrend, Downtrend: downtrend}, err
+	}, flat)
+}
+
 func Test_NewFibonacciLevels(t *testing.T) {
 	cc := map[string]struct {
 		Length int
@@ -384,6 +686,45 @@ func Test_FibonacciLevels_Count(t *testing.T) {
 	}.Count())
 }
 
+func Test_FibonacciLevels_Stream(t *testing.T) {
+	_, err := FibonacciLevels{}.Stream(decimal.Zero)
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	fl := FibonacciLevels{valid: true, length: 5}
+
+	_, err = fl.Stream(decimal.NewFromInt(2))
+	assertEqualError(t, ErrInvalidLevel, err)
+
+	level := decimal.RequireFromString("0.618")
+
+	s, err := fl.Stream(level)
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, fl.Count(), func(dd []decimal.Decimal) (decimal.Decimal, error) {
+		return fl.Calc(level, dd)
+	}, streamTestData())
+}
+
+func Test_FibonacciLevels_CalcSeries(t *testing.T) {
+	level := decimal.RequireFromString("0.618")
+
+	_, err := FibonacciLevels{}.CalcSeries(level, streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	fl := FibonacciLevels{valid: true, length: 5}
+
+	_, err = fl.CalcSeries(decimal.NewFromInt(-1), streamTestData())
+	assertEqualError(t, ErrInvalidLevel, err)
+
+	_, err = fl.CalcSeries(level, streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := fl.CalcSeries(level, streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, fl.Count(), func(dd []decimal.Decimal) (decimal.Decimal, error) {
+		return fl.Calc(level, dd)
+	}, streamTestData())
+}
+
 func Test_NewCCI(t *testing.T) {
 	cc := map[string]struct {
 		Type   MAType
@@ -435,6 +776,99 @@ func Test_NewCCI(t *testing.T) {
 	}
 }
 
+func Test_NewCCIWithFactor(t *testing.T) {
+	cc := map[string]struct {
+		Type   MAType
+		Length int
+		Factor decimal.Decimal
+		Result CCI
+		Error  error
+	}{
+		"NewSMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Invalid provided moving average type": {
+			Length: 1,
+			Error:  ErrInvalidMA,
+		},
+		"Validate returns an error": {
+			Type:   MATypeSimple,
+			Length: 10,
+			Factor: decimal.NewFromInt(-1),
+			Error:  ErrInvalidFactor,
+		},
+		"Successfully created new CCI with default factor": {
+			Type:   MATypeSimple,
+			Length: 10,
+			Result: CCI{
+				valid: true,
+				ma: SMA{
+					length: 10,
+					valid:  true,
+				},
+			},
+		},
+		"Successfully created new CCI": {
+			Type:   MATypeSimple,
+			Length: 10,
+			Factor: decimal.RequireFromString("0.02"),
+			Result: CCI{
+				valid: true,
+				ma: SMA{
+					length: 10,
+					valid:  true,
+				},
+				factor: decimal.RequireFromString("0.02"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCCIWithFactor(c.Type, c.Length, c.Factor)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CCI_validate(t *testing.T) {
+	cc := map[string]struct {
+		CCI   CCI
+		Error error
+	}{
+		"Invalid factor": {
+			CCI: CCI{
+				factor: decimal.NewFromInt(-1),
+			},
+			Error: ErrInvalidFactor,
+		},
+		"Successfully validated": {
+			CCI: CCI{
+				factor: decimal.RequireFromString("0.015"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.CCI.validate())
+
+			if c.Error == nil {
+				assert.True(t, c.CCI.valid)
+			}
+		})
+	}
+}
+
 func Test_CCI_Calc(t *testing.T) {
 	cc := map[string]struct {
 		CCI    CCI
@@ -496,6 +930,22 @@ func Test_CCI_Calc(t *testing.T) {
 			},
 			Result: decimal.NewFromInt(100),
 		},
+		"Successful calculation with custom factor": {
+			CCI: CCI{
+				valid: true,
+				ma: SMA{
+					length: 3,
+					valid:  true,
+				},
+				factor: decimal.RequireFromString("0.03"),
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(6),
+				decimal.NewFromInt(9),
+			},
+			Result: decimal.NewFromInt(50),
+		},
 	}
 
 	for cn, c := range cc {
@@ -516,6 +966,27 @@ func Test_CCI_Calc(t *testing.T) {
 	}
 }
 
+func Test_CCI_CalcCandles(t *testing.T) {
+	cci := CCI{
+		valid: true,
+		ma: SMA{
+			length: 3,
+			valid:  true,
+		},
+	}
+
+	_, err := cci.CalcCandles(nil)
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := cci.CalcCandles([]Candle{
+		{High: decimal.NewFromInt(4), Low: decimal.NewFromInt(2), Close: decimal.NewFromInt(3)},
+		{High: decimal.NewFromInt(7), Low: decimal.NewFromInt(5), Close: decimal.NewFromInt(6)},
+		{High: decimal.NewFromInt(11), Low: decimal.NewFromInt(7), Close: decimal.NewFromInt(9)},
+	})
+	assert.NoError(t, err)
+	assert.Equal(t, "100", res.String())
+}
+
 func Test_CCI_Count(t *testing.T) {
 	assert.Equal(t, 10, CCI{
 		ma: SMA{
@@ -524,10 +995,333 @@ func Test_CCI_Count(t *testing.T) {
 	}.Count())
 }
 
-func Test_NewROC(t *testing.T) {
-	cc := map[string]struct {
-		Length int
-		Result ROC
+func Test_CCI_Stream(t *testing.T) {
+	_, err := CCI{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := CCI{valid: true, ma: SMA{valid: true, length: 5}}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_CCI_CalcSeries(t *testing.T) {
+	_, err := CCI{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := CCI{valid: true, ma: SMA{valid: true, length: 5}}
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_CCI_StreamCandles(t *testing.T) {
+	_, err := CCI{}.StreamCandles()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := CCI{valid: true, ma: SMA{valid: true, length: 5}}
+
+	s, err := ind.StreamCandles()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.CalcCandles, candleTestData())
+}
+
+func Test_CCI_CalcSeriesCandles(t *testing.T) {
+	_, err := CCI{}.CalcSeriesCandles(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := CCI{valid: true, ma: SMA{valid: true, length: 5}}
+
+	_, err = ind.CalcSeriesCandles(candleTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeriesCandles(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.CalcCandles, candleTestData())
+}
+
+func Test_NewMACD(t *testing.T) {
+	cc := map[string]struct {
+		Fast   int
+		Slow   int
+		Signal int
+		Type   MAType
+		Result MACD
+		Error  error
+	}{
+		"Invalid fast length": {
+			Slow:   5,
+			Signal: 3,
+			Type:   MATypeSimple,
+			Error:  ErrInvalidLength,
+		},
+		"Invalid slow length": {
+			Fast:   3,
+			Signal: 3,
+			Type:   MATypeSimple,
+			Error:  ErrInvalidLength,
+		},
+		"Invalid signal length": {
+			Fast:  3,
+			Slow:  5,
+			Type:  MATypeSimple,
+			Error: ErrInvalidLength,
+		},
+		"Invalid provided moving average type": {
+			Fast:   3,
+			Slow:   5,
+			Signal: 3,
+			Error:  ErrInvalidMA,
+		},
+		"Validate returns an error": {
+			Fast:   5,
+			Slow:   3,
+			Signal: 3,
+			Type:   MATypeSimple,
+			Error:  ErrInvalidLength,
+		},
+		"Successfully created new MACD": {
+			Fast:   3,
+			Slow:   5,
+			Signal: 2,
+			Type:   MATypeSimple,
+			Result: MACD{
+				valid:  true,
+				fast:   SMA{valid: true, length: 3},
+				slow:   SMA{valid: true, length: 5},
+				signal: SMA{valid: true, length: 2},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMACD(c.Fast, c.Slow, c.Signal, c.Type)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_MACD_validate(t *testing.T) {
+	cc := map[string]struct {
+		MACD  MACD
+		Error error
+	}{
+		"Fast length equal to slow length": {
+			MACD: MACD{
+				fast:   SMA{valid: true, length: 3},
+				slow:   SMA{valid: true, length: 3},
+				signal: SMA{valid: true, length: 3},
+			},
+			Error: ErrInvalidLength,
+		},
+		"Successfully validated": {
+			MACD: MACD{
+				fast:   SMA{valid: true, length: 3},
+				slow:   SMA{valid: true, length: 5},
+				signal: SMA{valid: true, length: 3},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.MACD.validate())
+
+			if c.Error == nil {
+				assert.True(t, c.MACD.valid)
+			}
+		})
+	}
+}
+
+func Test_MACD_Calc(t *testing.T) {
+	cc := map[string]struct {
+		MACD            MACD
+		Data            []decimal.Decimal
+		LineResult      decimal.Decimal
+		SignalResult    decimal.Decimal
+		HistogramResult decimal.Decimal
+		Error           error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			MACD: MACD{
+				valid:  true,
+				fast:   SMA{valid: true, length: 3},
+				slow:   SMA{valid: true, length: 5},
+				signal: SMA{valid: true, length: 3},
+			},
+			Data:  streamTestData()[:6],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			MACD: MACD{
+				valid:  true,
+				fast:   SMA{valid: true, length: 3},
+				slow:   SMA{valid: true, length: 5},
+				signal: SMA{valid: true, length: 3},
+			},
+			Data:            streamTestData()[:7],
+			LineResult:      decimal.RequireFromString("-0.432"),
+			SignalResult:    decimal.RequireFromString("-0.1277777777777778"),
+			HistogramResult: decimal.RequireFromString("-0.3042222222222222"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			line, signal, histogram, err := c.MACD.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.LineResult.String(), line.String())
+			assert.Equal(t, c.SignalResult.String(), signal.String())
+			assert.Equal(t, c.HistogramResult.String(), histogram.String())
+		})
+	}
+}
+
+func Test_MACD_CalcOutput(t *testing.T) {
+	macd := MACD{
+		valid:  true,
+		fast:   SMA{valid: true, length: 3},
+		slow:   SMA{valid: true, length: 5},
+		signal: SMA{valid: true, length: 3},
+	}
+
+	cc := map[string]struct {
+		MACD   MACD
+		Output MACDOutput
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid output": {
+			MACD:  macd,
+			Data:  streamTestData()[:7],
+			Error: ErrInvalidMACDOutput,
+		},
+		"Invalid indicator": {
+			Output: MACDOutputLine,
+			Error:  ErrInvalidIndicator,
+		},
+		"Successful calculation with MACDOutputLine": {
+			MACD:   macd,
+			Output: MACDOutputLine,
+			Data:   streamTestData()[:7],
+			Result: decimal.RequireFromString("-0.432"),
+		},
+		"Successful calculation with MACDOutputSignal": {
+			MACD:   macd,
+			Output: MACDOutputSignal,
+			Data:   streamTestData()[:7],
+			Result: decimal.RequireFromString("-0.1277777777777778"),
+		},
+		"Successful calculation with MACDOutputHistogram": {
+			MACD:   macd,
+			Output: MACDOutputHistogram,
+			Data:   streamTestData()[:7],
+			Result: decimal.RequireFromString("-0.3042222222222222"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.MACD.CalcOutput(c.Data, c.Output)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_MACD_Count(t *testing.T) {
+	macd, err := NewMACD(12, 26, 9, MATypeExponential)
+	assert.NoError(t, err)
+	assert.Equal(t, 67, macd.Count())
+
+	macd, err = NewMACD(12, 26, 9, MATypeSimple)
+	assert.NoError(t, err)
+	assert.Equal(t, 34, macd.Count())
+}
+
+func Test_MACD_Stream(t *testing.T) {
+	_, err := MACD{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	macd, err := NewMACD(3, 5, 3, MATypeExponential)
+	assert.NoError(t, err)
+
+	s, err := macd.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, macd.Count(), func(dd []decimal.Decimal) (MACDResult, error) {
+		line, signal, histogram, err := macd.Calc(dd)
+
+		return MACDResult{MACD: line, Signal: signal, Histogram: histogram}, err
+	}, streamTestData())
+}
+
+func Test_MACD_CalcSeries(t *testing.T) {
+	_, err := MACD{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	macd, err := NewMACD(3, 5, 3, MATypeSimple)
+	assert.NoError(t, err)
+
+	_, err = macd.CalcSeries(streamTestData()[:6])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := macd.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, macd.Count(), func(dd []decimal.Decimal) (MACDResult, error) {
+		line, signal, histogram, err := macd.Calc(dd)
+
+		return MACDResult{MACD: line, Signal: signal, Histogram: histogram}, err
+	}, streamTestData())
+
+	macd, err = NewMACD(3, 5, 3, MATypeExponential)
+	assert.NoError(t, err)
+
+	res, err = macd.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, macd.Count(), func(dd []decimal.Decimal) (MACDResult, error) {
+		line, signal, histogram, err := macd.Calc(dd)
+
+		return MACDResult{MACD: line, Signal: signal, Histogram: histogram}, err
+	}, streamTestData())
+}
+
+func Test_NewROC(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ROC
 		Error  error
 	}{
 		"Validate returns an error": {
@@ -649,6 +1443,31 @@ func Test_ROC_Count(t *testing.T) {
 	}.Count())
 }
 
+func Test_ROC_Stream(t *testing.T) {
+	_, err := ROC{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := ROC{valid: true, length: 3}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_ROC_CalcSeries(t *testing.T) {
+	_, err := ROC{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := ROC{valid: true, length: 3}
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
 func Test_NewRSI(t *testing.T) {
 	cc := map[string]struct {
 		Length int
@@ -680,6 +1499,73 @@ func Test_NewRSI(t *testing.T) {
 	}
 }
 
+func Test_NewRSIWithSmoothing(t *testing.T) {
+	cc := map[string]struct {
+		Length    int
+		Smoothing RSISmoothing
+		Result    RSI
+		Error     error
+	}{
+		"Invalid smoothing": {
+			Length: 1,
+			Error:  ErrInvalidRSISmoothing,
+		},
+		"Unknown smoothing": {
+			Length:    1,
+			Smoothing: 70,
+			Error:     ErrInvalidRSISmoothing,
+		},
+		"Validate returns an error": {
+			Smoothing: RSISmoothingSimple,
+			Error:     assert.AnError,
+		},
+		"Successfully created new RSI": {
+			Length:    1,
+			Smoothing: RSISmoothingSimple,
+			Result: RSI{
+				valid:  true,
+				length: 1,
+			},
+		},
+		"Successfully created new RSI with Wilder smoothing": {
+			Length:    3,
+			Smoothing: RSISmoothingWilder,
+			Result: RSI{
+				valid:  true,
+				length: 3,
+				ma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 3},
+				},
+			},
+		},
+		"Successfully created new RSI with exponential smoothing": {
+			Length:    3,
+			Smoothing: RSISmoothingExponential,
+			Result: RSI{
+				valid:  true,
+				length: 3,
+				ma: EMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 3},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRSIWithSmoothing(c.Length, c.Smoothing)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
 func Test_RSI_validate(t *testing.T) {
 	cc := map[string]struct {
 		RSI   RSI
@@ -713,6 +1599,48 @@ func Test_RSI_validate(t *testing.T) {
 	}
 }
 
+func Test_NewRSIWithMA(t *testing.T) {
+	cc := map[string]struct {
+		Type   MAType
+		Length int
+		Result RSI
+		Error  error
+	}{
+		"Invalid moving average type": {
+			Length: 3,
+			Error:  ErrInvalidMA,
+		},
+		"NewMA returns an error": {
+			Type:  MATypeSmoothed,
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new RSI": {
+			Type:   MATypeSmoothed,
+			Length: 3,
+			Result: RSI{
+				valid:  true,
+				length: 3,
+				ma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 3},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewRSIWithMA(c.Type, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
 func Test_RSI_Calc(t *testing.T) {
 	cc := map[string]struct {
 		RSI    RSI
@@ -768,7 +1696,182 @@ func Test_RSI_Calc(t *testing.T) {
 				decimal.NewFromInt(12),
 				decimal.NewFromInt(8),
 			},
-			Result: decimal.NewFromInt(50),
+			Result: decimal.NewFromInt(50),
+		},
+		"Successful calculation with Wilder smoothing when average loss 0": {
+			RSI: RSI{
+				valid:  true,
+				length: 2,
+				ma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 2},
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(8),
+			},
+			Result: _hundred,
+		},
+		"Successful calculation with Wilder smoothing when average gain 0": {
+			RSI: RSI{
+				valid:  true,
+				length: 2,
+				ma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 2},
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(16),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(8),
+				decimal.NewFromInt(4),
+			},
+			Result: decimal.NewFromInt(0),
+		},
+		"Successful calculation with Wilder smoothing": {
+			RSI: RSI{
+				valid:  true,
+				length: 3,
+				ma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 3},
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(44),
+				decimal.NewFromInt(47),
+				decimal.NewFromInt(45),
+				decimal.NewFromInt(48),
+				decimal.NewFromInt(50),
+				decimal.NewFromInt(49),
+			},
+			Result: decimal.RequireFromString("67.9245283018867892"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.RSI.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_RSI_Count(t *testing.T) {
+	assert.Equal(t, 15, RSI{
+		length: 15,
+	}.Count())
+
+	assert.Equal(t, 28, RSI{
+		length: 14,
+		ma: SMMA{
+			sma: SMA{length: 14},
+		},
+	}.Count())
+}
+
+func Test_RSI_Stream(t *testing.T) {
+	_, err := RSI{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := RSI{valid: true, length: 3}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_RSI_CalcSeries(t *testing.T) {
+	_, err := RSI{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := RSI{valid: true, length: 4}
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+
+	flat := []decimal.Decimal{
+		decimal.NewFromInt(11),
+		decimal.NewFromInt(12),
+		decimal.NewFromInt(11),
+		decimal.NewFromInt(11),
+		decimal.NewFromInt(11),
+		decimal.NewFromInt(11),
+		decimal.NewFromInt(10),
+	}
+
+	res, err = ind.CalcSeries(flat)
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, flat)
+
+	ind, err = NewRSIWithSmoothing(3, RSISmoothingWilder)
+	assert.NoError(t, err)
+
+	res, err = ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_rsiChanges(t *testing.T) {
+	gains, losses := rsiChanges(streamTestData()[:1])
+	assert.Nil(t, gains)
+	assert.Nil(t, losses)
+
+	gains, losses = rsiChanges([]decimal.Decimal{
+		decimal.NewFromInt(8),
+		decimal.NewFromInt(12),
+		decimal.NewFromInt(12),
+		decimal.NewFromInt(9),
+	})
+	assert.Len(t, gains, 3)
+	assert.Len(t, losses, 3)
+	assert.Equal(t, "4", gains[0].String())
+	assert.Equal(t, "0", losses[0].String())
+	assert.Equal(t, "0", gains[1].String())
+	assert.Equal(t, "0", losses[1].String())
+	assert.Equal(t, "0", gains[2].String())
+	assert.Equal(t, "3", losses[2].String())
+}
+
+func Test_NewStochRSI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result StochRSI
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new StochRSI": {
+			Length: 5,
+			Result: StochRSI{
+				valid: true,
+				rsi: RSI{
+					valid:  true,
+					length: 5,
+				},
+				length: 5,
+				k:      SMA{valid: true, length: 1},
+				d:      SMA{valid: true, length: 1},
+			},
 		},
 	}
 
@@ -778,41 +1881,77 @@ func Test_RSI_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.RSI.Calc(c.Data)
+			res, err := NewStochRSI(c.Length)
 			assertEqualError(t, c.Error, err)
-
-			if err != nil {
-				return
-			}
-
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_RSI_Count(t *testing.T) {
-	assert.Equal(t, 15, RSI{
-		length: 15,
-	}.Count())
-}
-
-func Test_NewStochRSI(t *testing.T) {
+func Test_NewStochRSIWithLines(t *testing.T) {
 	cc := map[string]struct {
-		Length int
-		Result StochRSI
-		Error  error
+		RSILength   int
+		StochLength int
+		KSmoothing  int
+		DLength     int
+		Type        MAType
+		Result      StochRSI
+		Error       error
 	}{
+		"Invalid RSI length": {
+			StochLength: 14,
+			KSmoothing:  3,
+			DLength:     3,
+			Type:        MATypeSimple,
+			Error:       ErrInvalidLength,
+		},
+		"Invalid %K smoothing length": {
+			RSILength:   14,
+			StochLength: 14,
+			DLength:     3,
+			Type:        MATypeSimple,
+			Error:       ErrInvalidLength,
+		},
+		"Invalid %D length": {
+			RSILength:   14,
+			StochLength: 14,
+			KSmoothing:  3,
+			Type:        MATypeSimple,
+			Error:       ErrInvalidLength,
+		},
+		"Invalid provided moving average type": {
+			RSILength:   14,
+			StochLength: 14,
+			KSmoothing:  3,
+			DLength:     3,
+			Error:       ErrInvalidMA,
+		},
 		"Validate returns an error": {
-			Error: assert.AnError,
+			RSILength:  14,
+			KSmoothing: 3,
+			DLength:    3,
+			Type:       MATypeSimple,
+			Error:      ErrInvalidLength,
 		},
 		"Successfully created new StochRSI": {
-			Length: 1,
+			RSILength:   14,
+			StochLength: 10,
+			KSmoothing:  3,
+			DLength:     3,
+			Type:        MATypeSimple,
 			Result: StochRSI{
 				valid: true,
 				rsi: RSI{
-					length: 1,
 					valid:  true,
+					length: 14,
+					ma: SMMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 14},
+					},
 				},
+				length: 10,
+				k:      SMA{valid: true, length: 3},
+				d:      SMA{valid: true, length: 3},
 			},
 		},
 	}
@@ -823,13 +1962,44 @@ func Test_NewStochRSI(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewStochRSI(c.Length)
+			res, err := NewStochRSIWithLines(c.RSILength, c.StochLength, c.KSmoothing, c.DLength, c.Type)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
+func Test_StochRSI_validate(t *testing.T) {
+	cc := map[string]struct {
+		StochRSI StochRSI
+		Error    error
+	}{
+		"Invalid length": {
+			StochRSI: StochRSI{},
+			Error:    ErrInvalidLength,
+		},
+		"Successfully validated": {
+			StochRSI: StochRSI{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.StochRSI.validate())
+
+			if c.Error == nil {
+				assert.True(t, c.StochRSI.valid)
+			}
+		})
+	}
+}
+
 func Test_StochRSI_Calc(t *testing.T) {
 	cc := map[string]struct {
 		StochRSI StochRSI
@@ -848,11 +2018,14 @@ func Test_StochRSI_Calc(t *testing.T) {
 					length: 5,
 					valid:  true,
 				},
+				length: 5,
+				k:      SMA{valid: true, length: 1},
+				d:      SMA{valid: true, length: 1},
 			},
 			Data: []decimal.Decimal{
 				decimal.NewFromInt(30),
 			},
-			Error: assert.AnError,
+			Error: ErrInvalidDataSize,
 		},
 		"Successfully handled division by 0": {
 			StochRSI: StochRSI{
@@ -861,6 +2034,9 @@ func Test_StochRSI_Calc(t *testing.T) {
 					length: 3,
 					valid:  true,
 				},
+				length: 3,
+				k:      SMA{valid: true, length: 1},
+				d:      SMA{valid: true, length: 1},
 			},
 			Data: []decimal.Decimal{
 				decimal.NewFromInt(8),
@@ -871,13 +2047,36 @@ func Test_StochRSI_Calc(t *testing.T) {
 			},
 			Result: decimal.Zero,
 		},
-		"Successful calculation": {
+		"Successful calculation with the latest RSI": {
 			StochRSI: StochRSI{
 				valid: true,
 				rsi: RSI{
 					length: 3,
 					valid:  true,
 				},
+				length: 3,
+				k:      SMA{valid: true, length: 1},
+				d:      SMA{valid: true, length: 1},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(11),
+			},
+			Result: _one,
+		},
+		"Successful calculation with lines ignoring their warm-up": {
+			StochRSI: StochRSI{
+				valid: true,
+				rsi: RSI{
+					length: 3,
+					valid:  true,
+				},
+				length: 3,
+				k:      SMA{valid: true, length: 2},
+				d:      SMA{valid: true, length: 2},
 			},
 			Data: []decimal.Decimal{
 				decimal.NewFromInt(11),
@@ -886,7 +2085,7 @@ func Test_StochRSI_Calc(t *testing.T) {
 				decimal.NewFromInt(11),
 				decimal.NewFromInt(11),
 			},
-			Result: decimal.RequireFromString("0.5"),
+			Result: _one,
 		},
 	}
 
@@ -898,23 +2097,181 @@ func Test_StochRSI_Calc(t *testing.T) {
 
 			res, err := c.StochRSI.Calc(c.Data)
 			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
 
-			if err != nil {
-				return
-			}
+func Test_StochRSI_CalcLines(t *testing.T) {
+	cc := map[string]struct {
+		StochRSI StochRSI
+		Data     []decimal.Decimal
+		KResult  decimal.Decimal
+		DResult  decimal.Decimal
+		Error    error
+	}{
+		"Invalid indicator": {
+			StochRSI: StochRSI{},
+			Error:    ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			StochRSI: StochRSI{
+				valid: true,
+				rsi: RSI{
+					length: 3,
+					valid:  true,
+				},
+				length: 3,
+				k:      SMA{valid: true, length: 2},
+				d:      SMA{valid: true, length: 2},
+			},
+			Data:  streamTestData()[:5],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation without smoothing": {
+			StochRSI: StochRSI{
+				valid: true,
+				rsi: RSI{
+					length: 3,
+					valid:  true,
+				},
+				length: 3,
+				k:      SMA{valid: true, length: 1},
+				d:      SMA{valid: true, length: 1},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(12),
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(11),
+				decimal.NewFromInt(11),
+			},
+			KResult: _hundred,
+			DResult: _hundred,
+		},
+		"Successful calculation with smoothed lines": {
+			StochRSI: StochRSI{
+				valid: true,
+				rsi: RSI{
+					valid:  true,
+					length: 3,
+					ma: SMMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 3},
+					},
+				},
+				length: 3,
+				k:      SMA{valid: true, length: 2},
+				d:      SMA{valid: true, length: 2},
+			},
+			Data:    streamTestData()[:10],
+			KResult: decimal.NewFromInt(50),
+			DResult: decimal.NewFromInt(25),
+		},
+	}
 
-			assert.Equal(t, c.Result.String(), res.String())
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			k, d, err := c.StochRSI.CalcLines(c.Data)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.KResult.String(), k.String())
+			assert.Equal(t, c.DResult.String(), d.String())
 		})
 	}
 }
 
 func Test_StochRSI_Count(t *testing.T) {
-	assert.Equal(t, 29, StochRSI{
-		valid: false,
-		rsi: RSI{
-			length: 15,
-		},
-	}.Count())
+	s, err := NewStochRSI(14)
+	assert.NoError(t, err)
+	assert.Equal(t, 27, s.Count())
+	assert.Equal(t, 27, s.CountLines())
+
+	s, err = NewStochRSIWithLines(14, 14, 3, 3, MATypeSimple)
+	assert.NoError(t, err)
+	assert.Equal(t, 41, s.Count())
+	assert.Equal(t, 45, s.CountLines())
+}
+
+func Test_StochRSI_Stream(t *testing.T) {
+	_, err := StochRSI{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind, err := NewStochRSI(3)
+	assert.NoError(t, err)
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_StochRSI_StreamLines(t *testing.T) {
+	_, err := StochRSI{}.StreamLines()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind, err := NewStochRSIWithLines(3, 3, 2, 2, MATypeSimple)
+	assert.NoError(t, err)
+
+	s, err := ind.StreamLines()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.CountLines(), func(dd []decimal.Decimal) (StochRSIResult, error) {
+		k, d, err := ind.CalcLines(dd)
+
+		return StochRSIResult{K: k, D: d}, err
+	}, streamTestData())
+}
+
+func Test_StochRSI_CalcSeries(t *testing.T) {
+	_, err := StochRSI{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind, err := NewStochRSI(3)
+	assert.NoError(t, err)
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_StochRSI_CalcSeriesLines(t *testing.T) {
+	_, err := StochRSI{}.CalcSeriesLines(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := StochRSI{
+		valid:  true,
+		rsi:    RSI{valid: true, length: 3},
+		length: 3,
+		k:      SMA{valid: true, length: 2},
+		d:      SMA{valid: true, length: 2},
+	}
+
+	_, err = ind.CalcSeriesLines(streamTestData()[:ind.CountLines()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeriesLines(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.CountLines(), func(dd []decimal.Decimal) (StochRSIResult, error) {
+		k, d, err := ind.CalcLines(dd)
+
+		return StochRSIResult{K: k, D: d}, err
+	}, streamTestData())
+
+	ind, err = NewStochRSIWithLines(3, 3, 2, 2, MATypeExponential)
+	assert.NoError(t, err)
+
+	res, err = ind.CalcSeriesLines(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.CountLines(), func(dd []decimal.Decimal) (StochRSIResult, error) {
+		k, d, err := ind.CalcLines(dd)
+
+		return StochRSIResult{K: k, D: d}, err
+	}, streamTestData())
 }
 
 func Test_NewStoch(t *testing.T) {
@@ -1057,3 +2414,211 @@ func Test_Stoch_Calc(t *testing.T) {
 		})
 	}
 }
+
+func Test_Stoch_Stream(t *testing.T) {
+	_, err := Stoch{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	stoch := Stoch{valid: true, length: 5}
+
+	s, err := stoch.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, stoch.Count(), stoch.Calc, streamTestData())
+}
+
+func Test_Stoch_CalcSeries(t *testing.T) {
+	_, err := Stoch{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := Stoch{valid: true, length: 5}
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_NewFullStoch(t *testing.T) {
+	cc := map[string]struct {
+		Length     int
+		KSmoothing int
+		DLength    int
+		Type       MAType
+		Result     FullStoch
+		Error      error
+	}{
+		"Invalid length": {
+			KSmoothing: 3,
+			DLength:    3,
+			Type:       MATypeSimple,
+			Error:      ErrInvalidLength,
+		},
+		"Invalid %K smoothing length": {
+			Length:  14,
+			DLength: 3,
+			Type:    MATypeSimple,
+			Error:   ErrInvalidLength,
+		},
+		"Invalid %D length": {
+			Length:     14,
+			KSmoothing: 3,
+			Type:       MATypeSimple,
+			Error:      ErrInvalidLength,
+		},
+		"Invalid provided moving average type": {
+			Length:     14,
+			KSmoothing: 3,
+			DLength:    3,
+			Error:      ErrInvalidMA,
+		},
+		"Successfully created new FullStoch": {
+			Length:     14,
+			KSmoothing: 3,
+			DLength:    3,
+			Type:       MATypeSimple,
+			Result: FullStoch{
+				valid: true,
+				stoch: Stoch{valid: true, length: 14},
+				k:     SMA{valid: true, length: 3},
+				d:     SMA{valid: true, length: 3},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewFullStoch(c.Length, c.KSmoothing, c.DLength, c.Type)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_FullStoch_Calc(t *testing.T) {
+	cc := map[string]struct {
+		FullStoch FullStoch
+		Data      []Candle
+		KResult   decimal.Decimal
+		DResult   decimal.Decimal
+		Error     error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			FullStoch: FullStoch{
+				valid: true,
+				stoch: Stoch{valid: true, length: 3},
+				k:     SMA{valid: true, length: 2},
+				d:     SMA{valid: true, length: 2},
+			},
+			Data:  candleTestData()[:4],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			FullStoch: FullStoch{
+				valid: true,
+				stoch: Stoch{valid: true, length: 3},
+				k:     SMA{valid: true, length: 2},
+				d:     SMA{valid: true, length: 2},
+			},
+			Data:    candleTestData()[:5],
+			KResult: decimal.RequireFromString("53.28725992648962"),
+			DResult: decimal.RequireFromString("67.38233804732362"),
+		},
+		"Successful calculation with flat candles": {
+			FullStoch: FullStoch{
+				valid: true,
+				stoch: Stoch{valid: true, length: 2},
+				k:     SMA{valid: true, length: 1},
+				d:     SMA{valid: true, length: 1},
+			},
+			Data: []Candle{
+				{High: decimal.NewFromInt(5), Low: decimal.NewFromInt(5), Close: decimal.NewFromInt(5)},
+				{High: decimal.NewFromInt(5), Low: decimal.NewFromInt(5), Close: decimal.NewFromInt(5)},
+			},
+			KResult: decimal.Zero,
+			DResult: decimal.Zero,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			k, d, err := c.FullStoch.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.KResult.String(), k.String())
+			assert.Equal(t, c.DResult.String(), d.String())
+		})
+	}
+}
+
+func Test_FullStoch_Count(t *testing.T) {
+	fs, err := NewFullStoch(14, 3, 3, MATypeSimple)
+	assert.NoError(t, err)
+	assert.Equal(t, 18, fs.Count())
+
+	fs, err = NewFullStoch(14, 1, 3, MATypeSimple)
+	assert.NoError(t, err)
+	assert.Equal(t, 16, fs.Count())
+}
+
+func Test_FullStoch_Stream(t *testing.T) {
+	_, err := FullStoch{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	fs, err := NewFullStoch(3, 2, 2, MATypeExponential)
+	assert.NoError(t, err)
+
+	s, err := fs.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, fs.Count(), func(cc []Candle) (FullStochResult, error) {
+		k, d, err := fs.Calc(cc)
+
+		return FullStochResult{K: k, D: d}, err
+	}, candleTestData())
+}
+
+func Test_FullStoch_CalcSeries(t *testing.T) {
+	_, err := FullStoch{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	fs, err := NewFullStoch(3, 2, 2, MATypeSimple)
+	assert.NoError(t, err)
+
+	_, err = fs.CalcSeries(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := fs.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, fs.Count(), func(cc []Candle) (FullStochResult, error) {
+		k, d, err := fs.Calc(cc)
+
+		return FullStochResult{K: k, D: d}, err
+	}, candleTestData())
+
+	fs, err = NewFullStoch(3, 2, 2, MATypeExponential)
+	assert.NoError(t, err)
+
+	res, err = fs.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, fs.Count(), func(cc []Candle) (FullStochResult, error) {
+		k, d, err := fs.Calc(cc)
+
+		return FullStochResult{K: k, D: d}, err
+	}, candleTestData())
+}
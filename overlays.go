@@ -4,26 +4,215 @@ package tango
 
 import (
 	"math"
+	"time"
 
 	"github.com/shopspring/decimal"
 )
 
+// ALMA holds all the necessary information needed to calculate Arnaud
+// Legoux moving average.
+// The zero value is not usable.
+type ALMA struct {
+	// valid specifies whether ALMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+
+	// offset specifies the position of the gaussian curve peak within
+	// the window, from 0 (the oldest data point) to 1 (the newest one).
+	offset decimal.Decimal
+
+	// sigma specifies the sharpness of the gaussian curve.
+	sigma decimal.Decimal
+}
+
+// NewALMA validates provided configuration options and creates new ALMA
+// indicator. The default offset of 0.85 and sigma of 6 are used.
+func NewALMA(length int) (ALMA, error) {
+	return NewALMAWithOptions(length, decimal.RequireFromString("0.85"), decimal.NewFromInt(6))
+}
+
+// NewALMAWithOptions validates provided configuration options and creates
+// new ALMA indicator with the provided offset, which must be between 0 and
+// 1, and a positive sigma.
+func NewALMAWithOptions(length int, offset, sigma decimal.Decimal) (ALMA, error) {
+	alma := ALMA{
+		length: length,
+		offset: offset,
+		sigma:  sigma,
+	}
+
+	if err := alma.validate(); err != nil {
+		return ALMA{}, err
+	}
+
+	return alma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (alma *ALMA) validate() error {
+	if alma.length < 1 {
+		return ErrInvalidLength
+	}
+
+	if alma.offset.IsNegative() || alma.offset.GreaterThan(_one) {
+		return ErrInvalidOffset
+	}
+
+	if !alma.sigma.IsPositive() {
+		return ErrInvalidSigma
+	}
+
+	alma.valid = true
+
+	return nil
+}
+
+// Calc calculates ALMA from the provided data points slice.
+// Calculation is based on formula provided by Arnaud Legoux and
+// Dimitrios Kouzis-Loukas.
+// https://www.prorealcode.com/prorealtime-indicators/alma-arnaud-legoux-moving-average/.
+func (alma ALMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !alma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != alma.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	return alma.calc(dd, alma.weights()), nil
+}
+
+// calc calculates ALMA from the provided data points slice by using
+// precalculated weights.
+func (alma ALMA) calc(dd, ww []decimal.Decimal) decimal.Decimal {
+	var res, weight decimal.Decimal
+
+	for i := range dd {
+		res = res.Add(dd[i].Mul(ww[i]))
+		weight = weight.Add(ww[i])
+	}
+
+	return res.Div(weight)
+}
+
+// weights calculates gaussian weights of every data point of the window.
+func (alma ALMA) weights() []decimal.Decimal {
+	length := decimal.NewFromInt(int64(alma.length))
+
+	m := alma.offset.Mul(length.Sub(_one))
+	s := length.Div(alma.sigma)
+	divisor := s.Mul(s).Mul(decimal.NewFromInt(2))
+
+	res := make([]decimal.Decimal, alma.length)
+
+	for i := range res {
+		d := decimal.NewFromInt(int64(i)).Sub(m)
+		res[i] = exp(d.Mul(d).Div(divisor).Neg())
+	}
+
+	return res
+}
+
+// Count determines the total amount of data points needed for ALMA
+// calculation.
+func (alma ALMA) Count() int {
+	return alma.length
+}
+
+// CalcSeries calculates ALMA for every window of Count() data points of
+// the provided slice.
+func (alma ALMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !alma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < alma.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	ww := alma.weights()
+
+	return calcWindows(alma.Count(), dd, func(dd []decimal.Decimal) (decimal.Decimal, error) {
+		return alma.calc(dd, ww), nil
+	})
+}
+
+// Stream creates new ALMA stream that calculates ALMA from the most recent
+// data points each time a new data point is added.
+func (alma ALMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !alma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(alma.Count(), alma.Calc)
+}
+
+// BBResult holds all values produced by a single BB calculation.
+type BBResult struct {
+	// Upper is the upper band value.
+	Upper decimal.Decimal
+
+	// Middle is the middle band (moving average) value.
+	Middle decimal.Decimal
+
+	// Lower is the lower band value.
+	Lower decimal.Decimal
+
+	// Width is the band width value.
+	Width decimal.Decimal
+
+	// PercentB is the position of the latest data point within
+	// the bands.
+	PercentB decimal.Decimal
+}
+
 // BB holds all the necessary information needed to calculate Bollinger Bands.
 // The zero value is not usable.
 type BB struct {
 	// valid specifies whether BB paremeters were validated.
 	valid bool
 
-	// stdDev specifies how to adjust standard deviation.
+	// stdDev specifies how to adjust the deviation.
 	stdDev decimal.Decimal
 
 	// ma specifies MA indicator configuration.
 	ma MA
+
+	// dev specifies which deviation measure should be used. Population
+	// standard deviation is used when it is zero.
+	dev Deviation
+
+	// atr specifies ATR indicator configuration, it is used only
+	// with DeviationATR.
+	atr ATR
 }
 
 // NewBB validates provided configuration options and creates
-// new BB indicator.
+// new BB indicator. Population standard deviation is used.
 func NewBB(mat MAType, stdDev decimal.Decimal, length int) (BB, error) {
+	return newBB(mat, 0, stdDev, length)
+}
+
+// NewBBWithDeviation validates provided configuration options and creates
+// new BB indicator that uses the provided deviation measure.
+// DeviationATR requires candles, so only the candle methods, i.e.
+// CalcCandles, CalcBandCandles and CalcSeriesCandles, can be used with it;
+// ATR is smoothed with the same moving average type as the middle band.
+func NewBBWithDeviation(mat MAType, dev Deviation, stdDev decimal.Decimal, length int) (BB, error) {
+	if err := dev.Validate(); err != nil {
+		return BB{}, err
+	}
+
+	return newBB(mat, dev, stdDev, length)
+}
+
+// newBB creates new BB indicator without validating the deviation
+// measure.
+func newBB(mat MAType, dev Deviation, stdDev decimal.Decimal, length int) (BB, error) {
 	ma, err := NewMA(mat, length)
 	if err != nil {
 		return BB{}, err
@@ -32,6 +221,15 @@ func NewBB(mat MAType, stdDev decimal.Decimal, length int) (BB, error) {
 	bb := BB{
 		stdDev: stdDev,
 		ma:     ma,
+		dev:    dev,
+	}
+
+	if dev == DeviationATR {
+		bb.atr, err = NewATR(length, mat)
+		if err != nil {
+			// unlikely to happen
+			return BB{}, err
+		}
 	}
 
 	if err := bb.validate(); err != nil {
@@ -84,13 +282,57 @@ func (bb BB) CalcBand(dd []decimal.Decimal, band Band) (decimal.Decimal, error)
 		return decimal.Zero, err
 	}
 
+	return bb.calcBand(band, dd[len(dd)-1], res, sdev), nil
+}
+
+// CalcCandles calculates all BB values from the provided candles slice.
+// Closing prices are used for the middle band and the deviation, unless
+// DeviationATR is used.
+func (bb BB) CalcCandles(cc []Candle) (
+	upper decimal.Decimal,
+	lower decimal.Decimal,
+	width decimal.Decimal,
+	err error,
+) {
+
+	res, sdev, err := bb.calcCandles(cc)
+	if err != nil {
+		return decimal.Zero, decimal.Zero, decimal.Zero, err
+	}
+
+	return bb.calcUpper(res, sdev), bb.calcLower(res, sdev), bb.calcWidth(res, sdev), nil
+}
+
+// CalcBandCandles calculates specified BB value from the provided candles
+// slice. Closing prices are used for the middle band and the deviation,
+// unless DeviationATR is used.
+func (bb BB) CalcBandCandles(cc []Candle, band Band) (decimal.Decimal, error) {
+	if err := band.Validate(); err != nil {
+		return decimal.Zero, err
+	}
+
+	res, sdev, err := bb.calcCandles(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	return bb.calcBand(band, cc[len(cc)-1].Close, res, sdev), nil
+}
+
+// calcBand calculates specified BB value from the last data point, the
+// moving average value and the adjusted deviation.
+func (bb BB) calcBand(band Band, last, res, sdev decimal.Decimal) decimal.Decimal {
 	switch band {
 	case BandUpper:
-		return bb.calcUpper(res, sdev), nil
+		return bb.calcUpper(res, sdev)
+	case BandMiddle:
+		return res
 	case BandLower:
-		return bb.calcLower(res, sdev), nil
-	default: // BB is validated, only BandWidth is left.
-		return bb.calcWidth(res, sdev), nil
+		return bb.calcLower(res, sdev)
+	case BandPercentB:
+		return bb.calcPercentB(last, res, sdev)
+	default: // band is validated, only BandWidth is left.
+		return bb.calcWidth(res, sdev)
 	}
 }
 
@@ -104,6 +346,10 @@ func (bb BB) calc(dd []decimal.Decimal) (
 		return decimal.Zero, decimal.Zero, ErrInvalidIndicator
 	}
 
+	if bb.dev == DeviationATR {
+		return decimal.Zero, decimal.Zero, ErrInvalidDeviation
+	}
+
 	if len(dd) != bb.Count() {
 		return decimal.Zero, decimal.Zero, ErrInvalidDataSize
 	}
@@ -114,9 +360,59 @@ func (bb BB) calc(dd []decimal.Decimal) (
 		return decimal.Zero, decimal.Zero, err
 	}
 
-	sdev = StandardDeviation(dd).Mul(bb.stdDev)
+	return ma, bb.deviation(dd).Mul(bb.stdDev), nil
+}
+
+func (bb BB) calcCandles(cc []Candle) (
+	ma decimal.Decimal,
+	sdev decimal.Decimal,
+	err error,
+) {
+
+	if !bb.valid {
+		return decimal.Zero, decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != bb.Count() {
+		return decimal.Zero, decimal.Zero, ErrInvalidDataSize
+	}
+
+	dd := make([]decimal.Decimal, bb.ma.Count())
+
+	for i := range dd {
+		dd[i] = cc[len(cc)-len(dd)+i].Close
+	}
+
+	if bb.dev != DeviationATR {
+		return bb.calc(dd)
+	}
+
+	ma, err = bb.ma.Calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	atr, err := bb.atr.Calc(cc)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, err
+	}
+
+	return ma, atr.Mul(bb.stdDev), nil
+}
 
-	return ma, sdev, nil
+// deviation calculates the configured deviation measure of the provided
+// data points.
+func (bb BB) deviation(dd []decimal.Decimal) decimal.Decimal {
+	switch bb.dev {
+	case DeviationSample:
+		return SampleStandardDeviation(dd)
+	case DeviationMean:
+		return MeanDeviation(dd)
+	default:
+		return StandardDeviation(dd)
+	}
 }
 
 func (bb BB) calcUpper(res, sdev decimal.Decimal) decimal.Decimal {
@@ -131,360 +427,2264 @@ func (bb BB) calcWidth(res, sdev decimal.Decimal) decimal.Decimal {
 	return res.Add(sdev).Sub(res.Sub(sdev)).Div(res).Mul(_hundred)
 }
 
-// Count determines the total amount of data points needed for BB
-// calculation.
-func (bb BB) Count() int {
-	return bb.ma.Count()
+// result calculates all BB values from the last data point, the moving
+// average value and the adjusted standard deviation.
+func (bb BB) result(last, res, sdev decimal.Decimal) BBResult {
+	return BBResult{
+		Upper:    bb.calcUpper(res, sdev),
+		Middle:   res,
+		Lower:    bb.calcLower(res, sdev),
+		Width:    bb.calcWidth(res, sdev),
+		PercentB: bb.calcPercentB(last, res, sdev),
+	}
 }
 
-// DEMA holds all the necessary information needed to calculate
-// double exponential moving average.
-// The zero value is not usable.
-type DEMA struct {
-	// valid specifies whether DEMA paremeters were validated.
-	valid bool
+// calcPercentB calculates %B, which is 0 when the last data point is at
+// the lower band and 1 when it is at the upper band.
+func (bb BB) calcPercentB(last, res, sdev decimal.Decimal) decimal.Decimal {
+	dnm := bb.calcUpper(res, sdev).Sub(bb.calcLower(res, sdev))
+	if dnm.Equal(decimal.Zero) {
+		return decimal.Zero
+	}
 
-	// ema specifies what ema should be used for dema calculations.
-	ema EMA
+	return last.Sub(bb.calcLower(res, sdev)).Div(dnm)
 }
 
-// NewDEMA validates provided configuration options and creates
-// new DEMA indicator.
-func NewDEMA(length int) (DEMA, error) {
-	ema, err := NewEMA(length)
-	if err != nil {
-		return DEMA{}, err
+// Count determines the total amount of data points needed for BB
+// calculation. DeviationATR requires an additional candle for the true
+// range calculation.
+func (bb BB) Count() int {
+	if bb.dev == DeviationATR {
+		return bb.atr.Count()
 	}
 
-	return DEMA{
-		valid: true,
-		ema:   ema,
-	}, nil
+	return bb.ma.Count()
 }
 
-// Calc calculates DEMA from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/d/double-exponential-moving-average.asp.
-// All credits are due to Patrick Mulloy who developed DEMA indicator.
-func (dema DEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !dema.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// CalcSeries calculates all BB values for every window of Count() data
+// points of the provided slice. Standard deviation is calculated using
+// rolling sums, so the values may differ from the ones Calc produces only
+// by rounding.
+func (bb BB) CalcSeries(dd []decimal.Decimal) ([]BBResult, error) {
+	if !bb.valid {
+		return nil, ErrInvalidIndicator
 	}
 
-	if len(dd) != dema.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	if bb.dev == DeviationATR {
+		return nil, ErrInvalidDeviation
 	}
 
-	pres := make([]decimal.Decimal, dema.ema.sma.length)
-
-	var err error
+	if len(dd) < bb.Count() {
+		return nil, ErrInvalidDataSize
+	}
 
-	pres[0], err = dema.ema.sma.Calc(dd[:dema.ema.sma.length])
+	mas, err := CalcMASeries(bb.ma, dd)
 	if err != nil {
 		// unlikely to happen
-		return decimal.Zero, err
+		return nil, err
 	}
 
-	for i := dema.ema.sma.length; i < len(dd); i++ {
-		pres[i-dema.ema.sma.length+1], err = dema.ema.CalcNext(pres[i-dema.ema.sma.length], dd[i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
+	var sdevs []decimal.Decimal
+
+	switch bb.dev {
+	case DeviationSample:
+		sdevs = rollingStandardDeviations(dd, bb.Count(), true)
+	case DeviationMean:
+		sdevs, err = calcWindows(bb.Count(), dd, func(dd []decimal.Decimal) (decimal.Decimal, error) {
+			return MeanDeviation(dd), nil
+		})
+	default:
+		sdevs = rollingStandardDeviations(dd, bb.Count(), false)
+	}
+
+	if err != nil {
+		// unlikely to happen
+		return nil, err
 	}
 
-	res := pres[0]
+	res := make([]BBResult, len(mas))
 
-	for i := 0; i < len(pres); i++ {
-		res, err = dema.ema.CalcNext(res, pres[i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
+	for i := range res {
+		sdev := sdevs[i].Mul(bb.stdDev)
+
+		res[i] = bb.result(dd[i+bb.Count()-1], mas[i], sdev)
 	}
 
 	return res, nil
 }
 
-// Count determines the total amount of data points needed for DEMA
-// calculation.
-func (dema DEMA) Count() int {
-	return dema.ema.Count()
-}
+// CalcSeriesCandles calculates all BB values for every window of Count()
+// candles of the provided slice. Closing prices are used for the middle
+// band and the deviation, unless DeviationATR is used. The values are
+// identical to the ones CalcCandles produces.
+func (bb BB) CalcSeriesCandles(cc []Candle) ([]BBResult, error) {
+	if !bb.valid {
+		return nil, ErrInvalidIndicator
+	}
 
-// EMA holds all the necessary information needed to calculate exponential
-// moving average.
-// The zero value is not usable.
-type EMA struct {
-	// valid specifies whether DEMA paremeters were validated.
-	valid bool
+	if len(cc) < bb.Count() {
+		return nil, ErrInvalidDataSize
+	}
 
-	// sma specifies what sma should be used for ema calculations.
-	sma SMA
-}
+	dd := make([]decimal.Decimal, len(cc))
 
-// NewEMA validates provided configuration options and
-// creates new EMA indicator.
-func NewEMA(length int) (EMA, error) {
-	sma, err := NewSMA(length)
-	if err != nil {
-		return EMA{}, err
+	for i := range cc {
+		dd[i] = cc[i].Close
 	}
 
-	return EMA{
-		valid: true,
-		sma:   sma,
-	}, nil
-}
-
-// Calc calculates EMA from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/e/ema.asp.
-func (ema EMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !ema.valid {
-		return decimal.Zero, ErrInvalidIndicator
+	if bb.dev != DeviationATR {
+		return bb.CalcSeries(dd)
 	}
 
-	if len(dd) != ema.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	// the first candle of every window is used only for the true range.
+	dd = dd[bb.Count()-bb.ma.Count():]
+
+	mas, err := CalcMASeries(bb.ma, dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
 	}
 
-	res, err := ema.sma.Calc(dd[:ema.sma.length])
+	atrs, err := bb.atr.CalcSeries(cc)
 	if err != nil {
 		// unlikely to happen
-		return decimal.Zero, err
+		return nil, err
 	}
 
-	for i := ema.sma.length; i < len(dd); i++ {
-		res, err = ema.CalcNext(res, dd[i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
+	res := make([]BBResult, len(mas))
+
+	for i := range res {
+		sdev := atrs[i].Mul(bb.stdDev)
+
+		res[i] = bb.result(dd[i+bb.ma.Count()-1], mas[i], sdev)
 	}
 
 	return res, nil
 }
 
-// CalcNext calculates sequential EMA by using previous EMA.
-func (ema EMA) CalcNext(lres, dec decimal.Decimal) (decimal.Decimal, error) {
-	if !ema.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// Stream creates new BB stream that calculates all BB values from the
+// most recent data points each time a new data point is added.
+func (bb BB) Stream() (*Stream[decimal.Decimal, BBResult], error) {
+	if !bb.valid {
+		return nil, ErrInvalidIndicator
 	}
 
-	mtp := ema.multiplier()
-
-	return dec.Mul(mtp).Add(lres.Mul(decimal.NewFromInt(1).Sub(mtp))), nil
-}
+	if bb.dev == DeviationATR {
+		return nil, ErrInvalidDeviation
+	}
+
+	return NewStream(bb.Count(), func(dd []decimal.Decimal) (BBResult, error) {
+		res, sdev, err := bb.calc(dd)
+		if err != nil {
+			// unlikely to happen
+			return BBResult{}, err
+		}
+
+		return bb.result(dd[len(dd)-1], res, sdev), nil
+	})
+}
+
+// DCResult holds all values produced by a single DC calculation.
+type DCResult struct {
+	// Upper is the upper channel value.
+	Upper decimal.Decimal
+
+	// Middle is the middle channel value.
+	Middle decimal.Decimal
+
+	// Lower is the lower channel value.
+	Lower decimal.Decimal
+}
+
+// DC holds all the necessary information needed to calculate Donchian
+// Channels.
+// The zero value is not usable.
+type DC struct {
+	// valid specifies whether DC paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewDC validates provided configuration options and creates
+// new DC indicator.
+func NewDC(length int) (DC, error) {
+	dc := DC{
+		length: length,
+	}
+
+	if err := dc.validate(); err != nil {
+		return DC{}, err
+	}
+
+	return dc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (dc *DC) validate() error {
+	if dc.length < 1 {
+		return ErrInvalidLength
+	}
+
+	dc.valid = true
+
+	return nil
+}
+
+// Calc calculates all DC values from the provided candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/d/donchianchannels.asp.
+// All credits are due to Richard Donchian who developed DC indicator.
+func (dc DC) Calc(cc []Candle) (
+	upper decimal.Decimal,
+	middle decimal.Decimal,
+	lower decimal.Decimal,
+	err error,
+) {
+
+	if !dc.valid {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != dc.Count() {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidDataSize
+	}
+
+	upper = cc[0].High
+	lower = cc[0].Low
+
+	for i := 1; i < len(cc); i++ {
+		upper = decimal.Max(upper, cc[i].High)
+		lower = decimal.Min(lower, cc[i].Low)
+	}
+
+	return upper, dc.calcMiddle(upper, lower), lower, nil
+}
+
+// CalcBand calculates specified DC value from the provided candles slice.
+// Only BandUpper, BandMiddle and BandLower are supported.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/d/donchianchannels.asp.
+// All credits are due to Richard Donchian who developed DC indicator.
+func (dc DC) CalcBand(cc []Candle, band Band) (decimal.Decimal, error) {
+	if band != BandUpper && band != BandMiddle && band != BandLower {
+		return decimal.Zero, ErrInvalidBand
+	}
+
+	upper, middle, lower, err := dc.Calc(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	switch band {
+	case BandUpper:
+		return upper, nil
+	case BandMiddle:
+		return middle, nil
+	default: // band is validated, only BandLower is left.
+		return lower, nil
+	}
+}
+
+func (dc DC) calcMiddle(upper, lower decimal.Decimal) decimal.Decimal {
+	return upper.Add(lower).Div(decimal.NewFromInt(2))
+}
+
+// Count determines the total amount of candles needed for DC
+// calculation.
+func (dc DC) Count() int {
+	return dc.length
+}
+
+// CalcSeries calculates all DC values for every window of Count() candles
+// of the provided slice. Monotonic deques are used, so the calculation
+// takes linear time.
+func (dc DC) CalcSeries(cc []Candle) ([]DCResult, error) {
+	if !dc.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < dc.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	lows := make([]decimal.Decimal, len(cc))
+	highs := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		lows[i] = cc[i].Low
+		highs[i] = cc[i].High
+	}
+
+	lows, _ = rollingExtremes(lows, dc.length)
+	_, highs = rollingExtremes(highs, dc.length)
+
+	res := make([]DCResult, len(lows))
+
+	for i := range res {
+		res[i] = DCResult{
+			Upper:  highs[i],
+			Middle: dc.calcMiddle(highs[i], lows[i]),
+			Lower:  lows[i],
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new DC stream that calculates all DC values from the
+// most recent candles each time a new candle is added.
+func (dc DC) Stream() (*Stream[Candle, DCResult], error) {
+	if !dc.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(dc.Count(), func(cc []Candle) (DCResult, error) {
+		upper, middle, lower, err := dc.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return DCResult{}, err
+		}
+
+		return DCResult{
+			Upper:  upper,
+			Middle: middle,
+			Lower:  lower,
+		}, nil
+	})
+}
+
+// DEMA holds all the necessary information needed to calculate
+// double exponential moving average.
+// The zero value is not usable.
+type DEMA struct {
+	// valid specifies whether DEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for dema calculations.
+	ema EMA
+}
+
+// NewDEMA validates provided configuration options and creates
+// new DEMA indicator.
+func NewDEMA(length int) (DEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return DEMA{}, err
+	}
+
+	return DEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates DEMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/d/double-exponential-moving-average.asp.
+// All credits are due to Patrick Mulloy who developed DEMA indicator.
+func (dema DEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !dema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != dema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ee, err := dema.ema.chain(dd, 2)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return ee[1][len(ee[1])-1], nil
+}
+
+// Count determines the total amount of data points needed for DEMA
+// calculation.
+func (dema DEMA) Count() int {
+	return dema.ema.Count()
+}
+
+// CalcSeries calculates DEMA for every window of Count() data points of
+// the provided slice. Both EMAs are recalculated for every window, so the
+// values are identical to the ones Calc produces.
+func (dema DEMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !dema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(dema.Count(), dd, dema.Calc)
+}
+
+// CalcContinuousSeries calculates DEMA over the whole provided slice.
+// Both EMAs are seeded once and then smoothed up to the last data point,
+// so only the first value matches Calc.
+func (dema DEMA) CalcContinuousSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !dema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < dema.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	ee, err := dema.ema.chain(dd, 2)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return ee[1][dema.Count()-dema.ema.sma.length:], nil
+}
+
+// Stream creates new DEMA stream that calculates DEMA from the most recent
+// data points each time a new data point is added.
+func (dema DEMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !dema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(dema.Count(), dema.Calc)
+}
+
+// EMAOptions holds EMA seeding and smoothing configuration.
+type EMAOptions struct {
+	// Seed specifies how the first EMA value should be determined.
+	// EMASeedSimple is used when it is not set.
+	Seed EMASeed `json:"seed"`
+
+	// Value specifies EMA value preceding the first data point, it is
+	// used only with EMASeedValue. Since every Calc call is seeded with
+	// it, it is meant to continue a previously calculated EMA with
+	// CalcContinuousSeries or a single Calc call, so such EMA can't be
+	// streamed or used by other indicators.
+	Value decimal.Decimal `json:"value"`
+
+	// Alpha specifies the smoothing factor, e.g. 1/length for Wilder's
+	// smoothing. 2/(length+1) is used when it is not set. Wilder's
+	// smoothing of any length is also available as MATypeSmoothed.
+	Alpha decimal.Decimal `json:"alpha"`
+}
+
+// Validate checks whether EMA seed is supported and the smoothing factor
+// is between 0 and 1.
+func (opts EMAOptions) Validate() error {
+	if opts.Seed != 0 {
+		if err := opts.Seed.Validate(); err != nil {
+			return err
+		}
+	}
+
+	if opts.Alpha.IsNegative() || opts.Alpha.GreaterThan(_one) {
+		return ErrInvalidFactor
+	}
+
+	return nil
+}
+
+// EMA holds all the necessary information needed to calculate exponential
+// moving average.
+// The zero value is not usable.
+type EMA struct {
+	// valid specifies whether DEMA paremeters were validated.
+	valid bool
+
+	// sma specifies what sma should be used for ema calculations.
+	sma SMA
+
+	// seed specifies how the first EMA value should be determined.
+	// SMA seed is used when it is not set.
+	seed EMASeed
+
+	// value specifies EMA value preceding the first data point.
+	value decimal.Decimal
+
+	// alpha specifies the smoothing factor. 2/(length+1) is used when it
+	// is zero.
+	alpha decimal.Decimal
+}
+
+// NewEMA validates provided configuration options and
+// creates new EMA indicator.
+func NewEMA(length int) (EMA, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return EMA{}, err
+	}
+
+	return EMA{
+		valid: true,
+		sma:   sma,
+	}, nil
+}
+
+// NewEMAWithOptions validates provided configuration options and creates
+// new EMA indicator that is seeded and smoothed as specified by the
+// options.
+func NewEMAWithOptions(length int, opts EMAOptions) (EMA, error) {
+	if err := opts.Validate(); err != nil {
+		return EMA{}, err
+	}
+
+	ema, err := NewEMA(length)
+	if err != nil {
+		return EMA{}, err
+	}
+
+	ema.seed = opts.Seed
+	ema.alpha = opts.Alpha
+
+	if opts.Seed == EMASeedValue {
+		ema.value = opts.Value
+	}
+
+	return ema, nil
+}
+
+// NewEMAConstructor validates provided configuration options and creates
+// new moving average constructor of EMAs that are seeded and smoothed as
+// specified by the options. The constructor can be registered with
+// RegisterMA, so that the options are used by every indicator that
+// accepts MAType, e.g. MACD or BB. EMASeedValue can't be used, as the
+// same value would seed every calculation.
+func NewEMAConstructor(opts EMAOptions) (MAConstructor, error) {
+	if err := opts.Validate(); err != nil {
+		return nil, err
+	}
+
+	if opts.Seed == EMASeedValue {
+		return nil, ErrInvalidEMASeed
+	}
+
+	return func(length int) (MA, error) {
+		return NewEMAWithOptions(length, opts)
+	}, nil
+}
+
+// Calc calculates EMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/e/ema.asp.
+func (ema EMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !ema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != ema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, next, err := ema.start(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	for i := next; i < len(dd); i++ {
+		res, err = ema.CalcNext(res, dd[i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+	}
+
+	return res, nil
+}
+
+// start determines the first EMA value and the index of the data point
+// the smoothing should be continued from.
+func (ema EMA) start(dd []decimal.Decimal) (decimal.Decimal, int, error) {
+	switch ema.seed {
+	case EMASeedFirst:
+		return dd[0], 1, nil
+	case EMASeedValue:
+		return ema.value, 0, nil
+	default:
+		res, err := ema.sma.Calc(dd[:ema.sma.length])
+		if err != nil {
+			return decimal.Zero, 0, err
+		}
+
+		return res, ema.sma.length, nil
+	}
+}
+
+// CalcNext calculates sequential EMA by using previous EMA.
+func (ema EMA) CalcNext(lres, dec decimal.Decimal) (decimal.Decimal, error) {
+	if !ema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	mtp := ema.multiplier()
+
+	return dec.Mul(mtp).Add(lres.Mul(decimal.NewFromInt(1).Sub(mtp))), nil
+}
 
 // multiplier calculates EMA multiplier.
 func (ema EMA) multiplier() decimal.Decimal {
+	if !ema.alpha.IsZero() {
+		return ema.alpha
+	}
+
 	return decimal.NewFromInt(2).Div(decimal.NewFromInt(int64(ema.sma.length) + 1))
 }
 
-// Count determines the total amount of data points needed for EMA
+// Count determines the total amount of data points needed for EMA
+// calculation. SMA seeded EMA requires length data points to seed the
+// average, while other seeds require only length data points in total.
+func (ema EMA) Count() int {
+	switch ema.seed {
+	case EMASeedFirst, EMASeedValue:
+		return ema.sma.length
+	default:
+		return ema.sma.length*2 - 1
+	}
+}
+
+// CalcSeries calculates EMA for every window of Count() data points of
+// the provided slice. Every window is seeded separately, so the values are
+// identical to the ones Calc produces and the calculation takes
+// O(n*Count()) time.
+func (ema EMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !ema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(ema.Count(), dd, ema.Calc)
+}
+
+// CalcContinuousSeries calculates EMA over the whole provided slice
+// without reseeding. The first value matches Calc, every following data
+// point is added with CalcNext, as charting platforms do, so the
+// calculation takes linear time.
+func (ema EMA) CalcContinuousSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !ema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < ema.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, len(dd)-ema.Count()+1)
+
+	curr, next, err := ema.start(dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	for i := next - 1; i < len(dd); i++ {
+		if i >= next {
+			curr, err = ema.CalcNext(curr, dd[i])
+			if err != nil {
+				// unlikely to happen
+				return nil, err
+			}
+		}
+
+		if i >= ema.Count()-1 {
+			res[i-ema.Count()+1] = curr
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new EMA stream that calculates EMA from the most recent
+// data points each time a new data point is added. EMA seeded with
+// EMASeedValue can't be streamed, as every window would be seeded with
+// the same value, CalcNext should be used instead.
+func (ema EMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !ema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if ema.seed == EMASeedValue {
+		return nil, ErrInvalidEMASeed
+	}
+
+	return NewStream(ema.Count(), ema.Calc)
+}
+
+// chain calculates EMAs of the provided data points, EMAs of those EMAs
+// and so on, until depth EMA series are calculated. The first series is
+// seeded as configured, while every other series is seeded with the same
+// value as the first one, i.e. with the first value of the series it
+// smooths. Every series starts at the length-th data point.
+func (ema EMA) chain(dd []decimal.Decimal, depth int) ([][]decimal.Decimal, error) {
+	res := make([][]decimal.Decimal, depth)
+
+	for i := range res {
+		res[i] = make([]decimal.Decimal, len(dd)-ema.sma.length+1)
+	}
+
+	seed, next, err := ema.start(dd)
+	if err != nil {
+		return nil, err
+	}
+
+	curr := make([]decimal.Decimal, depth)
+
+	for i := range curr {
+		curr[i] = seed
+	}
+
+	for i := next - 1; i < len(dd); i++ {
+		if i >= next {
+			d := dd[i]
+
+			for j := range curr {
+				curr[j], err = ema.CalcNext(curr[j], d)
+				if err != nil {
+					return nil, err
+				}
+
+				d = curr[j]
+			}
+		}
+
+		if i >= ema.sma.length-1 {
+			for j := range curr {
+				res[j][i-ema.sma.length+1] = curr[j]
+			}
+		}
+	}
+
+	return res, nil
+}
+
+// HMA holds all the necessary information needed to calculate
+// hull moving average.
+// The zero value is not usable.
+type HMA struct {
+	// valid specifies whether HMA paremeters were validated.
+	valid bool
+
+	// wma specifies the base moving average.
+	wma WMA
+}
+
+// NewHMA validates provided configuration options and
+// creates new HMA indicator.
+func NewHMA(length int) (HMA, error) {
+	wma, err := NewWMA(length)
+	if err != nil {
+		return HMA{}, err
+	}
+
+	return HMA{
+		valid: true,
+		wma:   wma,
+	}, nil
+}
+
+// Calc calculates HMA from the provided data points slice.
+// Calculation is based on formula provided by fidelity.
+// https://www.fidelity.com/learning-center/trading-investing/technical-analysis/technical-indicator-guide/hull-moving-average.
+// All credits are due to Alan Hull who developed HMA indicator.
+func (h HMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !h.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != h.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	wma1 := WMA{length: h.wma.length / 2, valid: true}
+	wma2 := WMA{length: int(math.Sqrt(float64(h.wma.length))), valid: true}
+
+	res := make([]decimal.Decimal, wma2.length)
+
+	for i := 0; i < wma2.length; i++ {
+		res1, err := wma1.Calc(dd[i : wma1.length+i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+
+		res2, err := h.wma.Calc(dd[i : h.wma.length+i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+
+		res[i] = res1.Mul(decimal.NewFromInt(2)).Sub(res2)
+	}
+
+	return wma2.Calc(res)
+}
+
+// Count determines the total amount of data points needed for HMA
+// calculation.
+func (h HMA) Count() int {
+	return int(math.Sqrt(float64(h.wma.length))) + h.wma.length - 1
+}
+
+// CalcSeries calculates HMA for every window of Count() data points of
+// the provided slice. Weighted moving averages are rolled over the
+// series, so the calculation takes linear time.
+func (h HMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !h.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < h.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	wma1 := WMA{length: h.wma.length / 2, valid: true}
+	wma2 := WMA{length: int(math.Sqrt(float64(h.wma.length))), valid: true}
+
+	res1 := wma1.series(dd)
+	res2 := h.wma.series(dd)
+
+	res := make([]decimal.Decimal, len(res2))
+
+	for i := range res {
+		res[i] = res1[i].Mul(decimal.NewFromInt(2)).Sub(res2[i])
+	}
+
+	return wma2.series(res), nil
+}
+
+// Stream creates new HMA stream that calculates HMA from the most recent
+// data points each time a new data point is added.
+func (h HMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !h.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(h.Count(), h.Calc)
+}
+
+// KAMA holds all the necessary information needed to calculate Kaufman's
+// adaptive moving average.
+// The zero value is not usable.
+type KAMA struct {
+	// valid specifies whether KAMA paremeters were validated.
+	valid bool
+
+	// sma specifies what sma should be used to seed kama calculations.
+	sma SMA
+
+	// fast specifies the length of the fastest EMA the smoothing
+	// constant may reach.
+	fast int
+
+	// slow specifies the length of the slowest EMA the smoothing
+	// constant may reach.
+	slow int
+}
+
+// NewKAMA validates provided configuration options and creates new KAMA
+// indicator. The efficiency ratio is calculated over length data points,
+// while the default fastest and slowest EMA lengths of 2 and 30 are used.
+func NewKAMA(length int) (KAMA, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return KAMA{}, err
+	}
+
+	return KAMA{
+		valid: true,
+		sma:   sma,
+		fast:  2,
+		slow:  30,
+	}, nil
+}
+
+// Calc calculates KAMA from the provided data points slice. The first
+// length data points are used to seed the average with SMA.
+// Calculation is based on formula provided by stockcharts.
+// https://school.stockcharts.com/doku.php?id=technical_indicators:kaufman_s_adaptive_moving_average.
+// All credits are due to Perry Kaufman who developed KAMA indicator.
+func (kama KAMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !kama.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != kama.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := kama.series(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return res[len(res)-1], nil
+}
+
+// series calculates KAMA of every data point of the provided slice,
+// starting at the length-th one.
+func (kama KAMA) series(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	length := kama.sma.length
+	res := make([]decimal.Decimal, len(dd)-length+1)
+
+	var err error
+
+	res[0], err = kama.sma.Calc(dd[:length])
+	if err != nil {
+		return nil, err
+	}
+
+	fast := decimal.NewFromInt(2).Div(decimal.NewFromInt(int64(kama.fast + 1)))
+	slow := decimal.NewFromInt(2).Div(decimal.NewFromInt(int64(kama.slow + 1)))
+
+	// volatility holds the sum of absolute changes of the current window.
+	var volatility decimal.Decimal
+
+	for i := 1; i < length; i++ {
+		volatility = volatility.Add(dd[i].Sub(dd[i-1]).Abs())
+	}
+
+	for i := length; i < len(dd); i++ {
+		volatility = volatility.Add(dd[i].Sub(dd[i-1]).Abs())
+
+		if i > length {
+			volatility = volatility.Sub(dd[i-length].Sub(dd[i-length-1]).Abs())
+		}
+
+		er := decimal.Zero
+		if !volatility.IsZero() {
+			er = dd[i].Sub(dd[i-length]).Abs().Div(volatility)
+		}
+
+		sc := er.Mul(fast.Sub(slow)).Add(slow)
+		prev := res[i-length]
+
+		res[i-length+1] = prev.Add(sc.Mul(sc).Mul(dd[i].Sub(prev)))
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of data points needed for KAMA
+// calculation.
+func (kama KAMA) Count() int {
+	return kama.sma.length*2 - 1
+}
+
+// CalcSeries calculates KAMA for every window of Count() data points of
+// the provided slice. Every window is seeded with its own SMA, so the
+// values are identical to the ones Calc produces.
+func (kama KAMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !kama.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(kama.Count(), dd, kama.Calc)
+}
+
+// CalcContinuousSeries calculates KAMA over the whole provided slice,
+// adapting a single average seeded from the first data points. Only the
+// first value matches Calc.
+func (kama KAMA) CalcContinuousSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !kama.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < kama.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res, err := kama.series(dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return res[kama.Count()-kama.sma.length:], nil
+}
+
+// Stream creates new KAMA stream that calculates KAMA from the most recent
+// data points each time a new data point is added.
+func (kama KAMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !kama.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(kama.Count(), kama.Calc)
+}
+
+// KCResult holds all values produced by a single KC calculation.
+type KCResult struct {
+	// Upper is the upper channel value.
+	Upper decimal.Decimal
+
+	// Middle is the middle channel value.
+	Middle decimal.Decimal
+
+	// Lower is the lower channel value.
+	Lower decimal.Decimal
+}
+
+// KC holds all the necessary information needed to calculate Keltner
+// Channels.
+// The zero value is not usable.
+type KC struct {
+	// valid specifies whether KC paremeters were validated.
+	valid bool
+
+	// multiplier specifies how to adjust ATR.
+	multiplier decimal.Decimal
+
+	// ma specifies MA indicator configuration used for the middle line.
+	ma MA
+
+	// atr specifies ATR indicator configuration.
+	atr ATR
+}
+
+// NewKC validates provided configuration options and creates
+// new KC indicator. Wilder smoothed ATR is used.
+func NewKC(mat MAType, length, atrLength int, multiplier decimal.Decimal) (KC, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return KC{}, err
+	}
+
+	atr, err := NewATR(atrLength, MATypeSmoothed)
+	if err != nil {
+		return KC{}, err
+	}
+
+	kc := KC{
+		multiplier: multiplier,
+		ma:         ma,
+		atr:        atr,
+	}
+
+	if err := kc.validate(); err != nil {
+		return KC{}, err
+	}
+
+	return kc, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (kc *KC) validate() error {
+	if kc.multiplier.LessThanOrEqual(decimal.Zero) {
+		return ErrInvalidFactor
+	}
+
+	kc.valid = true
+
+	return nil
+}
+
+// Calc calculates all KC values from the provided candles slice. Closing
+// prices are used for the middle line.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/k/keltnerchannel.asp.
+// All credits are due to Chester Keltner who developed KC indicator.
+func (kc KC) Calc(cc []Candle) (
+	upper decimal.Decimal,
+	middle decimal.Decimal,
+	lower decimal.Decimal,
+	err error,
+) {
+
+	if !kc.valid {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != kc.Count() {
+		return decimal.Zero, decimal.Zero, decimal.Zero, ErrInvalidDataSize
+	}
+
+	dd := make([]decimal.Decimal, kc.ma.Count())
+
+	for i := range dd {
+		dd[i] = cc[len(cc)-len(dd)+i].Close
+	}
+
+	middle, err = kc.ma.Calc(dd)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, decimal.Zero, err
+	}
+
+	atr, err := kc.atr.Calc(cc[len(cc)-kc.atr.Count():])
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, decimal.Zero, decimal.Zero, err
+	}
+
+	res := kc.calc(middle, atr)
+
+	return res.Upper, res.Middle, res.Lower, nil
+}
+
+// CalcBand calculates specified KC value from the provided candles slice.
+// Only BandUpper, BandMiddle and BandLower are supported.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/k/keltnerchannel.asp.
+// All credits are due to Chester Keltner who developed KC indicator.
+func (kc KC) CalcBand(cc []Candle, band Band) (decimal.Decimal, error) {
+	if band != BandUpper && band != BandMiddle && band != BandLower {
+		return decimal.Zero, ErrInvalidBand
+	}
+
+	upper, middle, lower, err := kc.Calc(cc)
+	if err != nil {
+		return decimal.Zero, err
+	}
+
+	switch band {
+	case BandUpper:
+		return upper, nil
+	case BandMiddle:
+		return middle, nil
+	default: // band is validated, only BandLower is left.
+		return lower, nil
+	}
+}
+
+func (kc KC) calc(middle, atr decimal.Decimal) KCResult {
+	offset := atr.Mul(kc.multiplier)
+
+	return KCResult{
+		Upper:  middle.Add(offset),
+		Middle: middle,
+		Lower:  middle.Sub(offset),
+	}
+}
+
+// Count determines the total amount of candles needed for KC
+// calculation.
+func (kc KC) Count() int {
+	if kc.ma.Count() > kc.atr.Count() {
+		return kc.ma.Count()
+	}
+
+	return kc.atr.Count()
+}
+
+// CalcSeries calculates all KC values for every window of Count() candles
+// of the provided slice.
+func (kc KC) CalcSeries(cc []Candle) ([]KCResult, error) {
+	if !kc.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < kc.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	dd := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].Close
+	}
+
+	mas, err := CalcMASeries(kc.ma, dd)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	atrs, err := kc.atr.CalcSeries(cc)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	res := make([]KCResult, len(cc)-kc.Count()+1)
+
+	for i := range res {
+		res[i] = kc.calc(mas[len(mas)-len(res)+i], atrs[len(atrs)-len(res)+i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new KC stream that calculates all KC values from the
+// most recent candles each time a new candle is added.
+func (kc KC) Stream() (*Stream[Candle, KCResult], error) {
+	if !kc.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(kc.Count(), func(cc []Candle) (KCResult, error) {
+		upper, middle, lower, err := kc.Calc(cc)
+		if err != nil {
+			// unlikely to happen
+			return KCResult{}, err
+		}
+
+		return KCResult{
+			Upper:  upper,
+			Middle: middle,
+			Lower:  lower,
+		}, nil
+	})
+}
+
+// SMA holds all the necessary information needed to calculate simple
+// moving average.
+// The zero value is not usable.
+type SMA struct {
+	// valid specifies whether SMA paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewSMA validates provided configuration options and
+// creates new SMA indicator.
+func NewSMA(length int) (SMA, error) {
+	sma := SMA{
+		length: length,
+	}
+
+	if err := sma.validate(); err != nil {
+		return SMA{}, err
+	}
+
+	return sma, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (sma *SMA) validate() error {
+	if sma.length < 1 {
+		return ErrInvalidLength
+	}
+
+	sma.valid = true
+
+	return nil
+}
+
+// Calc calculates SMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/s/sma.asp.
+func (sma SMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !sma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != sma.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res := decimal.Zero
+	for i := 0; i < len(dd); i++ {
+		res = res.Add(dd[i])
+	}
+
+	return res.Div(decimal.NewFromInt(int64(sma.length))), nil
+}
+
+// Count determines the total amount of data points needed for SMA
+// calculation.
+func (sma SMA) Count() int {
+	return sma.length
+}
+
+// CalcSeries calculates SMA for every window of Count() data points of
+// the provided slice. Rolling sum is used, so the calculation takes
+// linear time.
+func (sma SMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !sma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < sma.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, len(dd)-sma.Count()+1)
+	length := decimal.NewFromInt(int64(sma.length))
+	sum := decimal.Zero
+
+	for i := range dd {
+		sum = sum.Add(dd[i])
+
+		if i >= sma.length {
+			sum = sum.Sub(dd[i-sma.length])
+		}
+
+		if i >= sma.length-1 {
+			res[i-sma.length+1] = sum.Div(length)
+		}
+	}
+
+	return res, nil
+}
+
+// Stream creates new SMA stream that calculates SMA from the most recent
+// data points each time a new data point is added.
+func (sma SMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !sma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(sma.Count(), sma.Calc)
+}
+
+// SMMA holds all the necessary information needed to calculate smoothed
+// moving average, also known as running moving average (RMA) or Wilder's
+// moving average.
+// The zero value is not usable.
+type SMMA struct {
+	// valid specifies whether SMMA paremeters were validated.
+	valid bool
+
+	// sma specifies what sma should be used for smma calculations.
+	sma SMA
+}
+
+// NewSMMA validates provided configuration options and
+// creates new SMMA indicator.
+func NewSMMA(length int) (SMMA, error) {
+	sma, err := NewSMA(length)
+	if err != nil {
+		return SMMA{}, err
+	}
+
+	return SMMA{
+		valid: true,
+		sma:   sma,
+	}, nil
+}
+
+// Calc calculates SMMA from the provided data points slice. The first
+// length data points are used to seed the average with SMA.
+// Calculation is based on formula provided by J. Welles Wilder Jr. in
+// "New Concepts in Technical Trading Systems".
+func (smma SMMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !smma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != smma.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := smma.sma.Calc(dd[:smma.sma.length])
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	for i := smma.sma.length; i < len(dd); i++ {
+		res, err = smma.CalcNext(res, dd[i])
+		if err != nil {
+			// unlikely to happen
+			return decimal.Zero, err
+		}
+	}
+
+	return res, nil
+}
+
+// CalcNext calculates sequential SMMA by using previous SMMA.
+func (smma SMMA) CalcNext(lres, dec decimal.Decimal) (decimal.Decimal, error) {
+	if !smma.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	length := decimal.NewFromInt(int64(smma.sma.length))
+
+	return lres.Mul(length.Sub(_one)).Add(dec).Div(length), nil
+}
+
+// Count determines the total amount of data points needed for SMMA
+// calculation.
+func (smma SMMA) Count() int {
+	return smma.sma.length*2 - 1
+}
+
+// CalcSeries calculates SMMA for every window of Count() data points of
+// the provided slice. The values are identical to the ones Calc produces,
+// since every window is seeded with its own SMA.
+func (smma SMMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !smma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(smma.Count(), dd, smma.Calc)
+}
+
+// CalcContinuousSeries calculates SMMA over the whole provided slice,
+// seeding it only once with the first Count() data points and adding the
+// rest with CalcNext. It returns as many values as CalcSeries, but only
+// the first one matches Calc.
+func (smma SMMA) CalcContinuousSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !smma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < smma.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, len(dd)-smma.Count()+1)
+
+	curr, err := smma.Calc(dd[:smma.Count()])
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	res[0] = curr
+
+	for i := smma.Count(); i < len(dd); i++ {
+		curr, err = smma.CalcNext(curr, dd[i])
+		if err != nil {
+			// unlikely to happen
+			return nil, err
+		}
+
+		res[i-smma.Count()+1] = curr
+	}
+
+	return res, nil
+}
+
+// Stream creates new SMMA stream that calculates SMMA from the most recent
+// data points each time a new data point is added.
+func (smma SMMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !smma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(smma.Count(), smma.Calc)
+}
+
+// T3 holds all the necessary information needed to calculate Tillson T3
+// moving average.
+// The zero value is not usable.
+type T3 struct {
+	// valid specifies whether T3 paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for t3 calculations.
+	ema EMA
+
+	// factor specifies the volume factor of generalized DEMAs.
+	factor decimal.Decimal
+}
+
+// NewT3 validates provided configuration options and creates new T3
+// indicator. The default volume factor of 0.7 is used.
+func NewT3(length int) (T3, error) {
+	return NewT3WithFactor(length, decimal.RequireFromString("0.7"))
+}
+
+// NewT3WithFactor validates provided configuration options and creates
+// new T3 indicator with the provided volume factor, which must be between
+// 0 and 1.
+func NewT3WithFactor(length int, factor decimal.Decimal) (T3, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return T3{}, err
+	}
+
+	t3 := T3{
+		ema:    ema,
+		factor: factor,
+	}
+
+	if err := t3.validate(); err != nil {
+		return T3{}, err
+	}
+
+	return t3, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (t3 *T3) validate() error {
+	if t3.factor.IsNegative() || t3.factor.GreaterThan(_one) {
+		return ErrInvalidFactor
+	}
+
+	t3.valid = true
+
+	return nil
+}
+
+// Calc calculates T3 from the provided data points slice.
+// Calculation is based on formula provided by Tim Tillson in "Smoothing
+// Techniques For More Accurate Signals".
+// All credits are due to Tim Tillson who developed T3 indicator.
+func (t3 T3) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !t3.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != t3.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ee, err := t3.ema.chain(dd, 6)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return t3.calc(ee, len(ee[0])-1), nil
+}
+
+// calc calculates T3 at the provided index of the EMA series.
+func (t3 T3) calc(ee [][]decimal.Decimal, i int) decimal.Decimal {
+	a := t3.factor
+	a2 := a.Mul(a)
+	a3 := a2.Mul(a)
+	three := decimal.NewFromInt(3)
+
+	c1 := a3.Neg()
+	c2 := a2.Mul(three).Add(a3.Mul(three))
+	c3 := a2.Mul(decimal.NewFromInt(-6)).Sub(a.Mul(three)).Sub(a3.Mul(three))
+	c4 := _one.Add(a.Mul(three)).Add(a3).Add(a2.Mul(three))
+
+	return c1.Mul(ee[5][i]).Add(c2.Mul(ee[4][i])).
+		Add(c3.Mul(ee[3][i])).Add(c4.Mul(ee[2][i]))
+}
+
+// Count determines the total amount of data points needed for T3
 // calculation.
-func (ema EMA) Count() int {
-	return ema.sma.length*2 - 1
+func (t3 T3) Count() int {
+	return t3.ema.Count()
+}
+
+// CalcSeries calculates T3 for every window of Count() data points of
+// the provided slice. All six EMAs are recalculated for every window, so
+// the values are identical to the ones Calc produces.
+func (t3 T3) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !t3.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(t3.Count(), dd, t3.Calc)
+}
+
+// CalcContinuousSeries calculates T3 over the whole provided slice. The
+// six chained EMAs are seeded once, so only the first value matches Calc.
+func (t3 T3) CalcContinuousSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !t3.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < t3.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	ee, err := t3.ema.chain(dd, 6)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	offset := t3.Count() - t3.ema.sma.length
+	res := make([]decimal.Decimal, len(ee[0])-offset)
+
+	for i := range res {
+		res[i] = t3.calc(ee, i+offset)
+	}
+
+	return res, nil
+}
+
+// Stream creates new T3 stream that calculates T3 from the most recent
+// data points each time a new data point is added.
+func (t3 T3) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !t3.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(t3.Count(), t3.Calc)
+}
+
+// TEMA holds all the necessary information needed to calculate
+// triple exponential moving average.
+// The zero value is not usable.
+type TEMA struct {
+	// valid specifies whether TEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for tema calculations.
+	ema EMA
+}
+
+// NewTEMA validates provided configuration options and creates
+// new TEMA indicator.
+func NewTEMA(length int) (TEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return TEMA{}, err
+	}
+
+	return TEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates TEMA from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/t/triple-exponential-moving-average.asp.
+// All credits are due to Patrick Mulloy who developed TEMA indicator.
+func (tema TEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !tema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != tema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	ee, err := tema.ema.chain(dd, 3)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return tema.calc(ee, len(ee[0])-1), nil
+}
+
+// calc calculates TEMA at the provided index of the EMA series.
+func (tema TEMA) calc(ee [][]decimal.Decimal, i int) decimal.Decimal {
+	three := decimal.NewFromInt(3)
+
+	return ee[0][i].Mul(three).Sub(ee[1][i].Mul(three)).Add(ee[2][i])
+}
+
+// Count determines the total amount of data points needed for TEMA
+// calculation.
+func (tema TEMA) Count() int {
+	return tema.ema.Count()
+}
+
+// CalcSeries calculates TEMA for every window of Count() data points of
+// the provided slice. All three EMAs are recalculated for every window,
+// so the values are identical to the ones Calc produces.
+func (tema TEMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !tema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(tema.Count(), dd, tema.Calc)
+}
+
+// CalcContinuousSeries calculates TEMA over the whole provided slice.
+// The chained EMAs are seeded once from the first data points, so only
+// the first value matches Calc.
+func (tema TEMA) CalcContinuousSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !tema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < tema.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	ee, err := tema.ema.chain(dd, 3)
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	offset := tema.Count() - tema.ema.sma.length
+	res := make([]decimal.Decimal, len(ee[0])-offset)
+
+	for i := range res {
+		res[i] = tema.calc(ee, i+offset)
+	}
+
+	return res, nil
+}
+
+// Stream creates new TEMA stream that calculates TEMA from the most recent
+// data points each time a new data point is added.
+func (tema TEMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !tema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(tema.Count(), tema.Calc)
+}
+
+// VWAP holds all the necessary information needed to calculate VWAP.
+// The zero value is not usable.
+type VWAP struct {
+	// valid specifies whether VWAP paremeters were validated.
+	valid bool
+
+	// length specifies how many data points should be used
+	// during the calculations.
+	length int
+}
+
+// NewVWAP validates provided configuration options and
+// creates new VWAP indicator.
+func NewVWAP(length int) (VWAP, error) {
+	vwap := VWAP{
+		length: length,
+	}
+
+	if err := vwap.validate(); err != nil {
+		return VWAP{}, err
+	}
+
+	return vwap, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (vwap *VWAP) validate() error {
+	if vwap.length < 1 {
+		return ErrInvalidLength
+	}
+
+	vwap.valid = true
+
+	return nil
+}
+
+// Calc calculates VWAP from the provided data points slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/v/vwap.asp.
+func (vwap VWAP) Calc(dd, vv []decimal.Decimal) (decimal.Decimal, error) {
+	if !vwap.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != vwap.Count() || len(dd) != len(vv) {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	var res, volume decimal.Decimal
+
+	for i := 0; i < len(dd); i++ {
+		res = res.Add(dd[i].Mul(vv[i]))
+		volume = volume.Add(vv[i])
+	}
+
+	if volume.IsZero() {
+		return dd[len(dd)-1], nil
+	}
+
+	return res.Div(volume), nil
+}
+
+// CalcCandles calculates VWAP from the provided candles slice. Typical
+// price of each candle is weighted by its volume.
+func (vwap VWAP) CalcCandles(cc []Candle) (decimal.Decimal, error) {
+	dd := make([]decimal.Decimal, len(cc))
+	vv := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].TypicalPrice()
+		vv[i] = cc[i].Volume
+	}
+
+	return vwap.Calc(dd, vv)
+}
+
+// Count determines the total amount of data points needed for VWAP
+// calculation.
+func (vwap VWAP) Count() int {
+	return vwap.length
+}
+
+// CalcSeries calculates VWAP for every window of Count() data points and
+// volumes of the provided slices. Rolling sums are used, so the
+// calculation takes linear time.
+func (vwap VWAP) CalcSeries(dd, vv []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !vwap.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < vwap.Count() || len(dd) != len(vv) {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]decimal.Decimal, len(dd)-vwap.Count()+1)
+
+	var sum, volume decimal.Decimal
+
+	for i := range dd {
+		sum = sum.Add(dd[i].Mul(vv[i]))
+		volume = volume.Add(vv[i])
+
+		if i >= vwap.length {
+			sum = sum.Sub(dd[i-vwap.length].Mul(vv[i-vwap.length]))
+			volume = volume.Sub(vv[i-vwap.length])
+		}
+
+		if i < vwap.length-1 {
+			continue
+		}
+
+		if volume.IsZero() {
+			res[i-vwap.length+1] = dd[i]
+			continue
+		}
+
+		res[i-vwap.length+1] = sum.Div(volume)
+	}
+
+	return res, nil
+}
+
+// Stream creates new VWAP stream that calculates VWAP from the most
+// recent data points and volumes each time a new pair is added.
+func (vwap VWAP) Stream() (*VWAPStream, error) {
+	if !vwap.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	s, err := NewStream(vwap.Count(), func(pp []vwapPoint) (decimal.Decimal, error) {
+		dd := make([]decimal.Decimal, len(pp))
+		vv := make([]decimal.Decimal, len(pp))
+
+		for i := range pp {
+			dd[i] = pp[i].price
+			vv[i] = pp[i].volume
+		}
+
+		return vwap.Calc(dd, vv)
+	})
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return &VWAPStream{stream: s}, nil
+}
+
+// VWAPStream holds the most recent data points and volumes required by
+// VWAP and recalculates it each time a new pair is added.
+// The zero value is not usable.
+type VWAPStream struct {
+	// stream specifies the underlying data points stream.
+	stream *Stream[vwapPoint, decimal.Decimal]
+}
+
+// vwapPoint holds a single data point and its volume.
+type vwapPoint struct {
+	price  decimal.Decimal
+	volume decimal.Decimal
+}
+
+// Update adds a new data point and its volume to the stream and calculates
+// VWAP from the most recent pairs. The returned boolean reports whether
+// the stream has collected enough data points.
+func (s *VWAPStream) Update(d, v decimal.Decimal) (decimal.Decimal, bool, error) {
+	if s.stream == nil {
+		return decimal.Zero, false, ErrInvalidIndicator
+	}
+
+	return s.stream.Update(vwapPoint{price: d, volume: v})
+}
+
+// Ready determines whether the stream has collected enough data points
+// for the calculation.
+func (s *VWAPStream) Ready() bool {
+	return s.stream != nil && s.stream.Ready()
+}
+
+// Reset removes all collected data points from the stream.
+func (s *VWAPStream) Reset() {
+	if s.stream != nil {
+		s.stream.Reset()
+	}
+}
+
+// Session specifies a daily trading session used to determine when
+// session based indicators should be reset.
+type Session struct {
+	// Start specifies the opening time of the session as an offset
+	// from midnight.
+	Start time.Duration
+
+	// Location specifies the time zone of the session. UTC is used
+	// when it is not set.
+	Location *time.Location
+}
+
+// Validate checks whether the session is valid or not.
+func (s Session) Validate() error {
+	if s.Start < 0 || s.Start >= 24*time.Hour {
+		return ErrInvalidSession
+	}
+
+	return nil
+}
+
+// StartOf returns the opening time of the session that the provided
+// time belongs to.
+func (s Session) StartOf(t time.Time) time.Time {
+	loc := s.Location
+	if loc == nil {
+		loc = time.UTC
+	}
+
+	t = t.In(loc)
+	y, m, d := t.Date()
+
+	// the wall clock time is used, so that days on which daylight saving
+	// time starts or ends don't shift the opening time.
+	h := int(s.Start / time.Hour)
+	mn := int(s.Start % time.Hour / time.Minute)
+	sec := int(s.Start % time.Minute / time.Second)
+	nsec := int(s.Start % time.Second)
+
+	start := time.Date(y, m, d, h, mn, sec, nsec, loc)
+	if start.After(t) {
+		start = time.Date(y, m, d-1, h, mn, sec, nsec, loc)
+	}
+
+	return start
+}
+
+// AnchoredVWAPResult holds VWAP and its standard deviation bands
+// produced by a single anchored VWAP calculation.
+type AnchoredVWAPResult struct {
+	// VWAP is the volume-weighted average price since the anchor.
+	VWAP decimal.Decimal
+
+	// StdDev is the volume-weighted standard deviation of the prices
+	// since the anchor.
+	StdDev decimal.Decimal
+
+	// Upper1 is VWAP plus one standard deviation.
+	Upper1 decimal.Decimal
+
+	// Lower1 is VWAP minus one standard deviation.
+	Lower1 decimal.Decimal
+
+	// Upper2 is VWAP plus two standard deviations.
+	Upper2 decimal.Decimal
+
+	// Lower2 is VWAP minus two standard deviations.
+	Lower2 decimal.Decimal
+
+	// Upper3 is VWAP plus three standard deviations.
+	Upper3 decimal.Decimal
+
+	// Lower3 is VWAP minus three standard deviations.
+	Lower3 decimal.Decimal
+}
+
+// AnchoredVWAP holds all the necessary information needed to calculate
+// VWAP anchored to the first candle, to a specific candle or to the
+// opening of each session.
+// The zero value is not usable.
+type AnchoredVWAP struct {
+	// valid specifies whether AnchoredVWAP paremeters were validated.
+	valid bool
+
+	// anchor specifies the time of the candle from which the calculation
+	// starts. The first candle is used when it is zero.
+	anchor time.Time
+
+	// reset specifies whether the calculation should be restarted at
+	// the opening of each session.
+	reset bool
+
+	// session specifies the session used to reset the calculation.
+	session Session
+}
+
+// NewAnchoredVWAP creates new AnchoredVWAP indicator that is anchored
+// to the first provided candle and is never reset.
+func NewAnchoredVWAP() (AnchoredVWAP, error) {
+	return AnchoredVWAP{
+		valid: true,
+	}, nil
+}
+
+// NewAnchoredVWAPAt creates new AnchoredVWAP indicator that is anchored
+// to the first candle opened at or after the provided time and is never
+// reset. Candles opened before the anchor are skipped.
+func NewAnchoredVWAPAt(anchor time.Time) (AnchoredVWAP, error) {
+	return AnchoredVWAP{
+		valid:  true,
+		anchor: anchor,
+	}, nil
 }
 
-// HMA holds all the necessary information needed to calculate
-// hull moving average.
-// The zero value is not usable.
-type HMA struct {
-	// valid specifies whether HMA paremeters were validated.
-	valid bool
+// NewSessionVWAP validates provided configuration options and creates
+// new AnchoredVWAP indicator that is reset at the opening of each
+// session, based on the opening times of the candles.
+func NewSessionVWAP(session Session) (AnchoredVWAP, error) {
+	if err := session.Validate(); err != nil {
+		return AnchoredVWAP{}, err
+	}
 
-	// wma specifies the base moving average.
-	wma WMA
+	return AnchoredVWAP{
+		valid:   true,
+		reset:   true,
+		session: session,
+	}, nil
 }
 
-// NewHMA validates provided configuration options and
-// creates new HMA indicator.
-func NewHMA(length int) (HMA, error) {
-	wma, err := NewWMA(length)
+// Calc calculates VWAP and its standard deviation bands of the last
+// candle from the provided candles slice. The first candle, or the one
+// the indicator is anchored to, is used as the anchor, so, unlike other
+// indicators, any amount of candles, not less than Count() since the
+// anchor, is accepted. Candles must be ordered from the oldest to the
+// newest. Typical price of each candle is weighted by its volume.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/v/vwap.asp.
+func (av AnchoredVWAP) Calc(cc []Candle) (AnchoredVWAPResult, error) {
+	res, err := av.CalcSeries(cc)
 	if err != nil {
-		return HMA{}, err
+		return AnchoredVWAPResult{}, err
 	}
 
-	return HMA{
-		valid: true,
-		wma:   wma,
-	}, nil
+	return res[len(res)-1], nil
 }
 
-// Calc calculates HMA from the provided data points slice.
-// Calculation is based on formula provided by fidelity.
-// https://www.fidelity.com/learning-center/trading-investing/technical-analysis/technical-indicator-guide/hull-moving-average.
-// All credits are due to Alan Hull who developed HMA indicator.
-func (h HMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !h.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// Count determines the minimum amount of candles needed for AnchoredVWAP
+// calculation.
+func (av AnchoredVWAP) Count() int {
+	return 1
+}
+
+// CalcSeries calculates VWAP and its standard deviation bands of every
+// candle of the provided slice, by accumulating them since the anchor or
+// the opening of the session. Candles opened before the anchor are
+// skipped, so the first value belongs to the anchor candle. The
+// calculation takes linear time.
+func (av AnchoredVWAP) CalcSeries(cc []Candle) ([]AnchoredVWAPResult, error) {
+	if !av.valid {
+		return nil, ErrInvalidIndicator
 	}
 
-	if len(dd) != h.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	for len(cc) > 0 && cc[0].Time.Before(av.anchor) {
+		cc = cc[1:]
 	}
 
-	wma1 := WMA{length: h.wma.length / 2, valid: true}
-	wma2 := WMA{length: int(math.Sqrt(float64(h.wma.length))), valid: true}
+	if len(cc) < av.Count() {
+		return nil, ErrInvalidDataSize
+	}
 
-	res := make([]decimal.Decimal, wma2.length)
+	res := make([]AnchoredVWAPResult, len(cc))
 
-	for i := 0; i < wma2.length; i++ {
-		res1, err := wma1.Calc(dd[i : wma1.length+i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
+	var state anchoredVWAPState
 
-		res2, err := h.wma.Calc(dd[i : h.wma.length+i])
-		if err != nil {
-			// unlikely to happen
-			return decimal.Zero, err
-		}
+	for i := range cc {
+		res[i] = av.update(&state, cc[i], i == 0)
+	}
 
-		res[i] = res1.Mul(decimal.NewFromInt(2)).Sub(res2)
+	return res, nil
+}
+
+// Stream creates new AnchoredVWAP stream that accumulates every added
+// candle since the anchor or the opening of the session.
+func (av AnchoredVWAP) Stream() (*AnchoredVWAPStream, error) {
+	if !av.valid {
+		return nil, ErrInvalidIndicator
 	}
 
-	return wma2.Calc(res)
+	return &AnchoredVWAPStream{av: av}, nil
 }
 
-// Count determines the total amount of data points needed for HMA
-// calculation.
-func (h HMA) Count() int {
-	return int(math.Sqrt(float64(h.wma.length))) + h.wma.length - 1
+// update adds the candle to the state, resets it beforehand if the
+// candle opens a new session, and calculates the result.
+func (av AnchoredVWAP) update(state *anchoredVWAPState, c Candle, first bool) AnchoredVWAPResult {
+	var start time.Time
+	if av.reset {
+		start = av.session.StartOf(c.Time)
+	}
+
+	if first || !start.Equal(state.start) {
+		*state = anchoredVWAPState{start: start}
+	}
+
+	price := c.TypicalPrice()
+
+	state.last = price
+	state.sum = state.sum.Add(price.Mul(c.Volume))
+	state.squares = state.squares.Add(price.Mul(price).Mul(c.Volume))
+	state.volume = state.volume.Add(c.Volume)
+
+	return state.result()
 }
 
-// SMA holds all the necessary information needed to calculate simple
-// moving average.
-// The zero value is not usable.
-type SMA struct {
-	// valid specifies whether SMA paremeters were validated.
-	valid bool
+// anchoredVWAPState holds the sums accumulated since the anchor.
+type anchoredVWAPState struct {
+	// start specifies the opening time of the current session.
+	start time.Time
 
-	// length specifies how many data points should be used
-	// during the calculations.
-	length int
+	// last specifies the typical price of the last candle.
+	last decimal.Decimal
+
+	// sum specifies the sum of volume weighted prices.
+	sum decimal.Decimal
+
+	// squares specifies the sum of volume weighted squared prices.
+	squares decimal.Decimal
+
+	// volume specifies the sum of volumes.
+	volume decimal.Decimal
 }
 
-// NewSMA validates provided configuration options and
-// creates new SMA indicator.
-func NewSMA(length int) (SMA, error) {
-	sma := SMA{
-		length: length,
-	}
+// result calculates VWAP and its standard deviation bands from the
+// accumulated sums.
+func (state anchoredVWAPState) result() AnchoredVWAPResult {
+	vwap := state.last
+	sdev := decimal.Zero
 
-	if err := sma.validate(); err != nil {
-		return SMA{}, err
+	if !state.volume.IsZero() {
+		vwap = state.sum.Div(state.volume)
+
+		variance := state.squares.Div(state.volume).Sub(vwap.Mul(vwap))
+		if variance.IsPositive() {
+			sdev = SquareRoot(variance)
+		}
 	}
 
-	return sma, nil
+	two := decimal.NewFromInt(2)
+	three := decimal.NewFromInt(3)
+
+	return AnchoredVWAPResult{
+		VWAP:   vwap,
+		StdDev: sdev,
+		Upper1: vwap.Add(sdev),
+		Lower1: vwap.Sub(sdev),
+		Upper2: vwap.Add(sdev.Mul(two)),
+		Lower2: vwap.Sub(sdev.Mul(two)),
+		Upper3: vwap.Add(sdev.Mul(three)),
+		Lower3: vwap.Sub(sdev.Mul(three)),
+	}
 }
 
-// validate checks whether the indicator has valid configuration properties.
-func (sma *SMA) validate() error {
-	if sma.length < 1 {
-		return ErrInvalidLength
-	}
+// AnchoredVWAPStream holds the sums accumulated by AnchoredVWAP and
+// recalculates it each time a new candle is added.
+// The zero value is not usable.
+type AnchoredVWAPStream struct {
+	// av specifies AnchoredVWAP indicator configuration.
+	av AnchoredVWAP
 
-	sma.valid = true
+	// state specifies the sums accumulated since the anchor.
+	state anchoredVWAPState
 
-	return nil
+	// ready specifies whether at least one candle was added.
+	ready bool
 }
 
-// Calc calculates SMA from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/s/sma.asp.
-func (sma SMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
-	if !sma.valid {
-		return decimal.Zero, ErrInvalidIndicator
+// Update adds a new candle to the stream and calculates VWAP and its
+// standard deviation bands. The returned boolean reports whether the
+// stream has collected enough candles. Candles opened before the anchor
+// are skipped.
+func (s *AnchoredVWAPStream) Update(c Candle) (AnchoredVWAPResult, bool, error) {
+	if !s.av.valid {
+		return AnchoredVWAPResult{}, false, ErrInvalidIndicator
 	}
 
-	if len(dd) != sma.Count() {
-		return decimal.Zero, ErrInvalidDataSize
+	if !s.ready && c.Time.Before(s.av.anchor) {
+		return AnchoredVWAPResult{}, false, nil
 	}
 
-	res := decimal.Zero
-	for i := 0; i < len(dd); i++ {
-		res = res.Add(dd[i])
-	}
+	res := s.av.update(&s.state, c, !s.ready)
+	s.ready = true
 
-	return res.Div(decimal.NewFromInt(int64(sma.length))), nil
+	return res, true, nil
 }
 
-// Count determines the total amount of data points needed for SMA
-// calculation.
-func (sma SMA) Count() int {
-	return sma.length
+// Ready determines whether the stream has collected enough candles
+// for the calculation.
+func (s *AnchoredVWAPStream) Ready() bool {
+	return s.ready
 }
 
-// VWAP holds all the necessary information needed to calculate VWAP.
+// Reset removes all accumulated candles from the stream, so the next
+// added candle becomes the new anchor.
+func (s *AnchoredVWAPStream) Reset() {
+	s.state = anchoredVWAPState{}
+	s.ready = false
+}
+
+// VWMA holds all the necessary information needed to calculate
+// volume-weighted moving average, which is VWAP of closing prices.
 // The zero value is not usable.
-type VWAP struct {
-	// valid specifies whether VWAP paremeters were validated.
+type VWMA struct {
+	// valid specifies whether VWMA paremeters were validated.
 	valid bool
 
-	// length specifies how many data points should be used
-	// during the calculations.
-	length int
+	// vwap specifies the base volume-weighted average price.
+	vwap VWAP
 }
 
-// NewVWAP validates provided configuration options and
-// creates new VWAP indicator.
-func NewVWAP(length int) (VWAP, error) {
-	vwap := VWAP{
-		length: length,
-	}
-
-	if err := vwap.validate(); err != nil {
-		return VWAP{}, err
+// NewVWMA validates provided configuration options and
+// creates new VWMA indicator.
+func NewVWMA(length int) (VWMA, error) {
+	vwap, err := NewVWAP(length)
+	if err != nil {
+		return VWMA{}, err
 	}
 
-	return vwap, nil
+	return VWMA{
+		valid: true,
+		vwap:  vwap,
+	}, nil
 }
 
-// validate checks whether the indicator has valid configuration properties.
-func (vwap *VWAP) validate() error {
-	if vwap.length < 1 {
-		return ErrInvalidLength
+// Calc calculates VWMA from the provided candles slice. Closing price of
+// each candle is weighted by its volume.
+func (vwma VWMA) Calc(cc []Candle) (decimal.Decimal, error) {
+	if !vwma.valid {
+		return decimal.Zero, ErrInvalidIndicator
 	}
 
-	vwap.valid = true
+	dd, vv := closesAndVolumes(cc)
 
-	return nil
+	return vwma.vwap.Calc(dd, vv)
 }
 
-// Calc calculates VWAP from the provided data points slice.
-// Calculation is based on formula provided by investopedia.
-// https://www.investopedia.com/terms/v/vwap.asp.
-func (vwap VWAP) Calc(dd, vv []decimal.Decimal) (decimal.Decimal, error) {
-	if !vwap.valid {
-		return decimal.Zero, ErrInvalidIndicator
-	}
+// Count determines the total amount of candles needed for VWMA
+// calculation.
+func (vwma VWMA) Count() int {
+	return vwma.vwap.Count()
+}
 
-	if len(dd) != vwap.Count() || len(dd) != len(vv) {
-		return decimal.Zero, ErrInvalidDataSize
+// CalcSeries calculates VWMA for every window of Count() candles of the
+// provided slice. Rolling sums are used, so the calculation takes linear
+// time.
+func (vwma VWMA) CalcSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !vwma.valid {
+		return nil, ErrInvalidIndicator
 	}
 
-	var res, volume decimal.Decimal
+	dd, vv := closesAndVolumes(cc)
 
-	for i := 0; i < len(dd); i++ {
-		res = res.Add(dd[i].Mul(vv[i]))
-		volume = volume.Add(vv[i])
-	}
+	return vwma.vwap.CalcSeries(dd, vv)
+}
 
-	if volume.IsZero() {
-		return dd[len(dd)-1], nil
+// Stream creates new VWMA stream that calculates VWMA from the most recent
+// candles each time a new candle is added.
+func (vwma VWMA) Stream() (*Stream[Candle, decimal.Decimal], error) {
+	if !vwma.valid {
+		return nil, ErrInvalidIndicator
 	}
 
-	return res.Div(volume), nil
+	return NewStream(vwma.Count(), vwma.Calc)
 }
 
-// Count determines the total amount of data points needed for VWAP
-// calculation.
-func (vwap VWAP) Count() int {
-	return vwap.length
+// closesAndVolumes splits the provided candles into closing prices and
+// volumes.
+func closesAndVolumes(cc []Candle) (dd, vv []decimal.Decimal) {
+	dd = make([]decimal.Decimal, len(cc))
+	vv = make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		dd[i] = cc[i].Close
+		vv[i] = cc[i].Volume
+	}
+
+	return dd, vv
 }
 
 // WMA holds all the necessary information needed to calculate weighted
@@ -538,13 +2738,16 @@ func (wma WMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 
 	res := decimal.Zero
 
-	weight := decimal.NewFromInt(int64(wma.length * (wma.length + 1))).Div(decimal.NewFromInt(2))
-
 	for i := 0; i < len(dd); i++ {
-		res = res.Add(dd[i].Mul(decimal.NewFromInt(int64(i + 1)).Div(weight)))
+		res = res.Add(dd[i].Mul(decimal.NewFromInt(int64(i + 1))))
 	}
 
-	return res, nil
+	weight := wma.weight()
+	if weight.IsZero() {
+		return decimal.Zero, nil
+	}
+
+	return res.Div(weight), nil
 }
 
 // Count determines the total amount of data points needed for WMA
@@ -552,3 +2755,179 @@ func (wma WMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
 func (wma WMA) Count() int {
 	return wma.length
 }
+
+// CalcSeries calculates WMA for every window of Count() data points of
+// the provided slice. Rolling sums are used, so the calculation takes
+// linear time.
+func (wma WMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !wma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < wma.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	return wma.series(dd), nil
+}
+
+// series calculates WMA for every window of the provided slice without
+// validating the indicator. The slice must not be shorter than the length.
+func (wma WMA) series(dd []decimal.Decimal) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(dd)-wma.length+1)
+
+	weight := wma.weight()
+	if weight.IsZero() {
+		return res
+	}
+
+	length := decimal.NewFromInt(int64(wma.length))
+
+	// sum holds the sum of the current window, while wsum holds
+	// the weighted sum of it.
+	var sum, wsum decimal.Decimal
+
+	for i := 0; i < wma.length; i++ {
+		sum = sum.Add(dd[i])
+		wsum = wsum.Add(dd[i].Mul(decimal.NewFromInt(int64(i + 1))))
+	}
+
+	res[0] = wsum.Div(weight)
+
+	for i := wma.length; i < len(dd); i++ {
+		wsum = wsum.Sub(sum).Add(dd[i].Mul(length))
+		sum = sum.Sub(dd[i-wma.length]).Add(dd[i])
+		res[i-wma.length+1] = wsum.Div(weight)
+	}
+
+	return res
+}
+
+// weight calculates the sum of all WMA weights.
+func (wma WMA) weight() decimal.Decimal {
+	return decimal.NewFromInt(int64(wma.length * (wma.length + 1))).Div(decimal.NewFromInt(2))
+}
+
+// Stream creates new WMA stream that calculates WMA from the most recent
+// data points each time a new data point is added.
+func (wma WMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !wma.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(wma.Count(), wma.Calc)
+}
+
+// ZLEMA holds all the necessary information needed to calculate zero lag
+// exponential moving average.
+// The zero value is not usable.
+type ZLEMA struct {
+	// valid specifies whether ZLEMA paremeters were validated.
+	valid bool
+
+	// ema specifies what ema should be used for zlema calculations.
+	ema EMA
+}
+
+// NewZLEMA validates provided configuration options and creates
+// new ZLEMA indicator.
+func NewZLEMA(length int) (ZLEMA, error) {
+	ema, err := NewEMA(length)
+	if err != nil {
+		return ZLEMA{}, err
+	}
+
+	return ZLEMA{
+		valid: true,
+		ema:   ema,
+	}, nil
+}
+
+// Calc calculates ZLEMA from the provided data points slice. Every data
+// point is adjusted by its change over the lag of (length-1)/2 data points
+// before EMA is calculated.
+// Calculation is based on formula provided by John Ehlers and Ric Way in
+// "Zero Lag (Well, Almost)".
+func (zlema ZLEMA) Calc(dd []decimal.Decimal) (decimal.Decimal, error) {
+	if !zlema.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(dd) != zlema.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := zlema.ema.Calc(zlema.adjust(dd))
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return res, nil
+}
+
+// adjust removes the lag from every data point of the provided slice,
+// except the first lag ones.
+func (zlema ZLEMA) adjust(dd []decimal.Decimal) []decimal.Decimal {
+	lag := zlema.lag()
+	res := make([]decimal.Decimal, len(dd)-lag)
+
+	for i := range res {
+		res[i] = dd[i+lag].Add(dd[i+lag].Sub(dd[i]))
+	}
+
+	return res
+}
+
+// lag calculates the amount of data points the lag is removed over.
+func (zlema ZLEMA) lag() int {
+	return (zlema.ema.sma.length - 1) / 2
+}
+
+// Count determines the total amount of data points needed for ZLEMA
+// calculation.
+func (zlema ZLEMA) Count() int {
+	return zlema.ema.Count() + zlema.lag()
+}
+
+// CalcSeries calculates ZLEMA for every window of Count() data points of
+// the provided slice. The adjusted data points of every window are passed
+// to a separately seeded EMA, so the values are identical to the ones
+// Calc produces.
+func (zlema ZLEMA) CalcSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !zlema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(zlema.Count(), dd, zlema.Calc)
+}
+
+// CalcContinuousSeries calculates ZLEMA over the whole provided slice
+// with EMA.CalcContinuousSeries, so only the first value matches Calc.
+func (zlema ZLEMA) CalcContinuousSeries(dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if !zlema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(dd) < zlema.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	res, err := zlema.ema.CalcContinuousSeries(zlema.adjust(dd))
+	if err != nil {
+		// unlikely to happen
+		return nil, err
+	}
+
+	return res, nil
+}
+
+// Stream creates new ZLEMA stream that calculates ZLEMA from the most
+// recent data points each time a new data point is added.
+func (zlema ZLEMA) Stream() (*Stream[decimal.Decimal, decimal.Decimal], error) {
+	if !zlema.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(zlema.Count(), zlema.Calc)
+}
@@ -2,11 +2,171 @@ package tango
 
 import (
 	"testing"
+	"time"
 
 	"github.com/shopspring/decimal"
 	"github.com/stretchr/testify/assert"
 )
 
+func Test_NewALMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ALMA
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new ALMA": {
+			Length: 3,
+			Result: ALMA{
+				valid:  true,
+				length: 3,
+				offset: decimal.RequireFromString("0.85"),
+				sigma:  decimal.NewFromInt(6),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewALMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewALMAWithOptions(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Offset decimal.Decimal
+		Sigma  decimal.Decimal
+		Result ALMA
+		Error  error
+	}{
+		"Invalid length": {
+			Offset: decimal.RequireFromString("0.5"),
+			Sigma:  decimal.NewFromInt(6),
+			Error:  ErrInvalidLength,
+		},
+		"Invalid offset": {
+			Length: 3,
+			Offset: decimal.RequireFromString("1.1"),
+			Sigma:  decimal.NewFromInt(6),
+			Error:  ErrInvalidOffset,
+		},
+		"Invalid sigma": {
+			Length: 3,
+			Offset: decimal.RequireFromString("0.5"),
+			Error:  ErrInvalidSigma,
+		},
+		"Successfully created new ALMA": {
+			Length: 3,
+			Offset: decimal.RequireFromString("0.5"),
+			Sigma:  decimal.NewFromInt(4),
+			Result: ALMA{
+				valid:  true,
+				length: 3,
+				offset: decimal.RequireFromString("0.5"),
+				sigma:  decimal.NewFromInt(4),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewALMAWithOptions(c.Length, c.Offset, c.Sigma)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ALMA_Calc(t *testing.T) {
+	alma, err := NewALMA(3)
+	assert.NoError(t, err)
+
+	cc := map[string]struct {
+		ALMA   ALMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ALMA:  alma,
+			Data:  streamTestData()[:2],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ALMA:   alma,
+			Data:   streamTestData()[:3],
+			Result: decimal.RequireFromString("64.54115447"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ALMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ALMA_Count(t *testing.T) {
+	assert.Equal(t, 5, ALMA{length: 5}.Count())
+}
+
+func Test_ALMA_CalcSeries(t *testing.T) {
+	_, err := ALMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	alma, err := NewALMA(3)
+	assert.NoError(t, err)
+
+	_, err = alma.CalcSeries(streamTestData()[:2])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := streamTestData()
+
+	res, err := alma.CalcSeries(dd)
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, alma.Count(), alma.Calc, dd)
+}
+
+func Test_ALMA_Stream(t *testing.T) {
+	_, err := ALMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	alma, err := NewALMA(3)
+	assert.NoError(t, err)
+
+	s, err := alma.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, alma.Count(), alma.Calc, streamTestData())
+}
+
 func Test_NewBB(t *testing.T) {
 	cc := map[string]struct {
 		MAType MAType
@@ -51,6 +211,82 @@ func Test_NewBB(t *testing.T) {
 	}
 }
 
+func Test_NewBBWithDeviation(t *testing.T) {
+	cc := map[string]struct {
+		MAType    MAType
+		Deviation Deviation
+		StdDev    decimal.Decimal
+		Length    int
+		Result    BB
+		Error     error
+	}{
+		"Invalid deviation": {
+			MAType: MATypeSimple,
+			Length: 1,
+			Error:  ErrInvalidDeviation,
+		},
+		"Invalid moving average": {
+			Deviation: DeviationPopulation,
+			Error:     ErrInvalidMA,
+		},
+		"Validate returns an error": {
+			MAType:    MATypeSimple,
+			Deviation: DeviationPopulation,
+			Length:    1,
+			Error:     ErrInvalidStandardDeviation,
+		},
+		"Successfully created new BB": {
+			MAType:    MATypeSimple,
+			Deviation: DeviationPopulation,
+			StdDev:    decimal.RequireFromString("2.5"),
+			Length:    5,
+			Result: BB{
+				valid:  true,
+				stdDev: decimal.RequireFromString("2.5"),
+				ma: SMA{
+					length: 5,
+					valid:  true,
+				},
+				dev: DeviationPopulation,
+			},
+		},
+		"Successfully created new BB with ATR deviation": {
+			MAType:    MATypeSimple,
+			Deviation: DeviationATR,
+			StdDev:    decimal.RequireFromString("2.5"),
+			Length:    5,
+			Result: BB{
+				valid:  true,
+				stdDev: decimal.RequireFromString("2.5"),
+				ma: SMA{
+					length: 5,
+					valid:  true,
+				},
+				dev: DeviationATR,
+				atr: ATR{
+					valid: true,
+					ma: SMA{
+						length: 5,
+						valid:  true,
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewBBWithDeviation(c.MAType, c.Deviation, c.StdDev, c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
 func Test_BB_validate(t *testing.T) {
 	cc := map[string]struct {
 		BB    BB
@@ -105,6 +341,20 @@ func Test_BB_Calc(t *testing.T) {
 			},
 			Error: ErrInvalidIndicator,
 		},
+		"Invalid deviation": {
+			BB: BB{
+				valid: true,
+				ma: SMA{
+					valid:  true,
+					length: 1,
+				},
+				dev: DeviationATR,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDeviation,
+		},
 		"Invalid data size": {
 			BB: BB{
 				valid: true,
@@ -248,6 +498,99 @@ func Test_BB_CalcBand(t *testing.T) {
 			},
 			Result: decimal.RequireFromString("31.31218222"),
 		},
+		"Successful calculation with BandUpper and sample deviation": {
+			BB: BB{
+				valid:  true,
+				stdDev: decimal.RequireFromString("1"),
+				ma: SMA{
+					length: 5,
+					valid:  true,
+				},
+				dev: DeviationSample,
+			},
+			Band: BandUpper,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(40),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.RequireFromString("39.12310563"),
+		},
+		"Successful calculation with BandUpper and mean deviation": {
+			BB: BB{
+				valid:  true,
+				stdDev: decimal.RequireFromString("1"),
+				ma: SMA{
+					length: 5,
+					valid:  true,
+				},
+				dev: DeviationMean,
+			},
+			Band: BandUpper,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(40),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.RequireFromString("38.2"),
+		},
+		"Successful calculation with BandMiddle": {
+			BB: BB{
+				valid:  true,
+				stdDev: decimal.RequireFromString("1"),
+				ma: SMA{
+					length: 5,
+					valid:  true,
+				},
+			},
+			Band: BandMiddle,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+				decimal.NewFromInt(35),
+				decimal.NewFromInt(40),
+				decimal.NewFromInt(38),
+				decimal.NewFromInt(32),
+			},
+			Result: decimal.NewFromInt(35),
+		},
+		"Successful calculation with BandPercentB": {
+			BB: BB{
+				valid:  true,
+				stdDev: decimal.RequireFromString("2"),
+				ma: SMA{
+					length: 4,
+					valid:  true,
+				},
+			},
+			Band: BandPercentB,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(6),
+			},
+			Result: decimal.RequireFromString("0.85355339"),
+		},
+		"Successful calculation with BandPercentB when bands are equal": {
+			BB: BB{
+				valid:  true,
+				stdDev: decimal.RequireFromString("2"),
+				ma: SMA{
+					length: 2,
+					valid:  true,
+				},
+			},
+			Band: BandPercentB,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(4),
+			},
+			Result: decimal.Zero,
+		},
 		"Successful calculation with BandWidth": {
 			BB: BB{
 				valid:  true,
@@ -302,41 +645,424 @@ func Test_BB_CalcBand(t *testing.T) {
 	}
 }
 
-func Test_BB_Count(t *testing.T) {
-	assert.Equal(t, 1, BB{ma: SMA{length: 1}}.Count())
-}
+func Test_BB_CalcCandles(t *testing.T) {
+	_, _, _, err := BB{}.CalcCandles(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidIndicator, err)
 
-func Test_NewDEMA(t *testing.T) {
-	cc := map[string]struct {
-		Length int
-		Result DEMA
-		Error  error
-	}{
-		"NewEMA returns an error": {
-			Error: assert.AnError,
-		},
-		"Successfully created new DEMA": {
-			Length: 1,
-			Result: DEMA{
-				valid: true,
-				ema: EMA{
-					sma: SMA{
-						length: 1,
-						valid:  true,
-					},
-					valid: true,
-				},
-			},
-		},
-	}
+	bb, err := NewBBWithDeviation(MATypeSimple, DeviationATR, decimal.NewFromInt(2), 3)
+	assert.NoError(t, err)
 
-	for cn, c := range cc {
-		c := c
+	_, _, _, err = bb.CalcCandles(candleTestData()[:3])
+	assertEqualError(t, ErrInvalidDataSize, err)
 
-		t.Run(cn, func(t *testing.T) {
-			t.Parallel()
+	upper, lower, width, err := bb.CalcCandles(candleTestData()[:4])
+	assert.NoError(t, err)
+	assert.Equal(t, "66.2566666666666667", upper.String())
+	assert.Equal(t, "62.8299999999999999", lower.String())
+	assert.Equal(t, "5.30909467", width.Round(8).String())
 
-			res, err := NewDEMA(c.Length)
+	bb, err = NewBBWithDeviation(MATypeSimple, DeviationPopulation, decimal.NewFromInt(2), 5)
+	assert.NoError(t, err)
+
+	dd := streamTestData()[:5]
+
+	upper, lower, width, err = bb.CalcCandles(candleTestData()[:5])
+	assert.NoError(t, err)
+
+	expUpper, expLower, expWidth, err := bb.Calc(dd)
+	assert.NoError(t, err)
+	assert.Equal(t, expUpper.String(), upper.String())
+	assert.Equal(t, expLower.String(), lower.String())
+	assert.Equal(t, expWidth.String(), width.String())
+}
+
+func Test_BB_CalcBandCandles(t *testing.T) {
+	bb, err := NewBBWithDeviation(MATypeSimple, DeviationATR, decimal.NewFromInt(2), 3)
+	assert.NoError(t, err)
+
+	_, err = bb.CalcBandCandles(candleTestData()[:4], 0)
+	assertEqualError(t, ErrInvalidBand, err)
+
+	_, err = bb.CalcBandCandles(candleTestData()[:3], BandUpper)
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := bb.CalcBandCandles(candleTestData()[:4], BandMiddle)
+	assert.NoError(t, err)
+	assert.Equal(t, "64.5433333333333333", res.String())
+
+	res, err = bb.CalcBandCandles(candleTestData()[:4], BandPercentB)
+	assert.NoError(t, err)
+	assert.Equal(t, "0.56031128", res.Round(8).String())
+}
+
+func Test_BB_Count(t *testing.T) {
+	assert.Equal(t, 1, BB{ma: SMA{length: 1}}.Count())
+	assert.Equal(t, 4, BB{
+		ma:  SMA{length: 3},
+		dev: DeviationATR,
+		atr: ATR{ma: SMA{length: 3}},
+	}.Count())
+}
+
+func Test_BB_Stream(t *testing.T) {
+	_, err := BB{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	_, err = BB{valid: true, dev: DeviationATR}.Stream()
+	assertEqualError(t, ErrInvalidDeviation, err)
+
+	bb := BB{valid: true, stdDev: decimal.NewFromInt(2), ma: SMA{valid: true, length: 5}}
+
+	s, err := bb.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, bb.Count(), func(dd []decimal.Decimal) (BBResult, error) {
+		upper, lower, width, err := bb.Calc(dd)
+		if err != nil {
+			return BBResult{}, err
+		}
+
+		middle, err := bb.CalcBand(dd, BandMiddle)
+		if err != nil {
+			return BBResult{}, err
+		}
+
+		percentB, err := bb.CalcBand(dd, BandPercentB)
+
+		return BBResult{
+			Upper:    upper,
+			Middle:   middle,
+			Lower:    lower,
+			Width:    width,
+			PercentB: percentB,
+		}, err
+	}, streamTestData())
+}
+
+func Test_BB_CalcSeries(t *testing.T) {
+	_, err := BB{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	_, err = BB{valid: true, dev: DeviationATR}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidDeviation, err)
+
+	bb := BB{valid: true, stdDev: decimal.NewFromInt(2), ma: SMA{valid: true, length: 5}}
+
+	_, err = bb.CalcSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := streamTestData()
+
+	for _, dev := range []Deviation{DeviationSample, DeviationMean} {
+		bb.dev = dev
+
+		res, err := bb.CalcSeries(dd)
+		assert.NoError(t, err)
+		assert.Len(t, res, len(dd)-bb.Count()+1)
+
+		for i := range res {
+			upper, lower, _, err := bb.Calc(dd[i : i+bb.Count()])
+			assert.NoError(t, err)
+			assert.Equal(t, upper.Round(8).String(), res[i].Upper.Round(8).String())
+			assert.Equal(t, lower.Round(8).String(), res[i].Lower.Round(8).String())
+		}
+	}
+
+	bb.dev = DeviationPopulation
+
+	res, err := bb.CalcSeries(dd)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(dd)-bb.Count()+1)
+
+	for i := range res {
+		upper, lower, width, err := bb.Calc(dd[i : i+bb.Count()])
+		assert.NoError(t, err)
+		assert.Equal(t, upper.Round(8).String(), res[i].Upper.Round(8).String())
+		assert.Equal(t, lower.Round(8).String(), res[i].Lower.Round(8).String())
+		assert.Equal(t, width.Round(8).String(), res[i].Width.Round(8).String())
+
+		middle, err := bb.CalcBand(dd[i:i+bb.Count()], BandMiddle)
+		assert.NoError(t, err)
+		assert.Equal(t, middle.String(), res[i].Middle.String())
+
+		percentB, err := bb.CalcBand(dd[i:i+bb.Count()], BandPercentB)
+		assert.NoError(t, err)
+		assert.Equal(t, percentB.Round(8).String(), res[i].PercentB.Round(8).String())
+	}
+}
+
+func Test_BB_CalcSeriesCandles(t *testing.T) {
+	cc := candleTestData()
+
+	_, err := BB{}.CalcSeriesCandles(cc)
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	bb, err := NewBB(MATypeSimple, decimal.NewFromInt(2), 5)
+	assert.NoError(t, err)
+
+	_, err = bb.CalcSeriesCandles(cc[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := bb.CalcSeriesCandles(cc)
+	assert.NoError(t, err)
+
+	exp, err := bb.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assert.Equal(t, exp, res)
+
+	bb, err = NewBBWithDeviation(MATypeSimple, DeviationATR, decimal.NewFromInt(2), 3)
+	assert.NoError(t, err)
+
+	_, err = bb.CalcSeriesCandles(cc[:3])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err = bb.CalcSeriesCandles(cc)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(cc)-bb.Count()+1)
+
+	for i := range res {
+		upper, lower, width, err := bb.CalcCandles(cc[i : i+bb.Count()])
+		assert.NoError(t, err)
+		assert.Equal(t, upper.String(), res[i].Upper.String())
+		assert.Equal(t, lower.String(), res[i].Lower.String())
+		assert.Equal(t, width.String(), res[i].Width.String())
+
+		middle, err := bb.CalcBandCandles(cc[i:i+bb.Count()], BandMiddle)
+		assert.NoError(t, err)
+		assert.Equal(t, middle.String(), res[i].Middle.String())
+
+		percentB, err := bb.CalcBandCandles(cc[i:i+bb.Count()], BandPercentB)
+		assert.NoError(t, err)
+		assert.Equal(t, percentB.String(), res[i].PercentB.String())
+	}
+}
+
+func Test_NewDC(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result DC
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new DC": {
+			Length: 20,
+			Result: DC{
+				valid:  true,
+				length: 20,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDC(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_DC_validate(t *testing.T) {
+	cc := map[string]struct {
+		DC    DC
+		Error error
+	}{
+		"Invalid length": {
+			DC:    DC{},
+			Error: ErrInvalidLength,
+		},
+		"Successfully validated": {
+			DC: DC{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.DC.validate())
+
+			if c.Error == nil {
+				assert.True(t, c.DC.valid)
+			}
+		})
+	}
+}
+
+func Test_DC_Calc(t *testing.T) {
+	cc := map[string]struct {
+		DC           DC
+		Data         []Candle
+		UpperResult  decimal.Decimal
+		MiddleResult decimal.Decimal
+		LowerResult  decimal.Decimal
+		Error        error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			DC:    DC{valid: true, length: 3},
+			Data:  candleTestData()[:2],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			DC:           DC{valid: true, length: 3},
+			Data:         candleTestData()[:3],
+			UpperResult:  decimal.RequireFromString("64.96"),
+			MiddleResult: decimal.RequireFromString("64.295"),
+			LowerResult:  decimal.RequireFromString("63.63"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			upper, middle, lower, err := c.DC.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.UpperResult.String(), upper.String())
+			assert.Equal(t, c.MiddleResult.String(), middle.String())
+			assert.Equal(t, c.LowerResult.String(), lower.String())
+		})
+	}
+}
+
+func Test_DC_CalcBand(t *testing.T) {
+	cc := map[string]struct {
+		DC     DC
+		Band   Band
+		Data   []Candle
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid band": {
+			DC:    DC{valid: true, length: 3},
+			Band:  BandWidth,
+			Data:  candleTestData()[:3],
+			Error: ErrInvalidBand,
+		},
+		"Invalid indicator": {
+			Band:  BandUpper,
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with BandUpper": {
+			DC:     DC{valid: true, length: 3},
+			Band:   BandUpper,
+			Data:   candleTestData()[:3],
+			Result: decimal.RequireFromString("64.96"),
+		},
+		"Successful calculation with BandMiddle": {
+			DC:     DC{valid: true, length: 3},
+			Band:   BandMiddle,
+			Data:   candleTestData()[:3],
+			Result: decimal.RequireFromString("64.295"),
+		},
+		"Successful calculation with BandLower": {
+			DC:     DC{valid: true, length: 3},
+			Band:   BandLower,
+			Data:   candleTestData()[:3],
+			Result: decimal.RequireFromString("63.63"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.DC.CalcBand(c.Data, c.Band)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_DC_Count(t *testing.T) {
+	assert.Equal(t, 20, DC{length: 20}.Count())
+}
+
+func Test_DC_Stream(t *testing.T) {
+	_, err := DC{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	dc := DC{valid: true, length: 5}
+
+	s, err := dc.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, dc.Count(), func(cc []Candle) (DCResult, error) {
+		upper, middle, lower, err := dc.Calc(cc)
+
+		return DCResult{Upper: upper, Middle: middle, Lower: lower}, err
+	}, candleTestData())
+}
+
+func Test_DC_CalcSeries(t *testing.T) {
+	_, err := DC{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	dc := DC{valid: true, length: 5}
+
+	_, err = dc.CalcSeries(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := dc.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, dc.Count(), func(cc []Candle) (DCResult, error) {
+		upper, middle, lower, err := dc.Calc(cc)
+
+		return DCResult{Upper: upper, Middle: middle, Lower: lower}, err
+	}, candleTestData())
+}
+
+func Test_NewDEMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result DEMA
+		Error  error
+	}{
+		"NewEMA returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new DEMA": {
+			Length: 1,
+			Result: DEMA{
+				valid: true,
+				ema: EMA{
+					sma: SMA{
+						length: 1,
+						valid:  true,
+					},
+					valid: true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewDEMA(c.Length)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
@@ -421,6 +1147,104 @@ func Test_DEMA_Count(t *testing.T) {
 	}.Count())
 }
 
+func Test_DEMA_Stream(t *testing.T) {
+	_, err := DEMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := DEMA{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 3}}}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_DEMA_CalcSeries(t *testing.T) {
+	_, err := DEMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	dema := DEMA{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 3}}}
+
+	_, err = dema.CalcSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := dema.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, dema.Count(), dema.Calc, streamTestData())
+}
+
+func Test_DEMA_CalcContinuousSeries(t *testing.T) {
+	_, err := DEMA{}.CalcContinuousSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	dema := DEMA{valid: true, ema: EMA{valid: true, sma: SMA{valid: true, length: 3}}}
+
+	_, err = dema.CalcContinuousSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := []decimal.Decimal{
+		decimal.NewFromInt(31),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(3),
+		decimal.NewFromInt(5),
+	}
+
+	res, err := dema.CalcContinuousSeries(dd)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "6.75", res[0].String())
+	assert.Equal(t, "5.8125", res[1].String())
+
+	// smoothing continues over the whole series, so only the first value
+	// matches the one calculated from a separate window.
+	exp, err := dema.Calc(dd[:dema.Count()])
+	assert.NoError(t, err)
+	assert.Equal(t, exp.String(), res[0].String())
+
+	exp, err = dema.Calc(dd[1:])
+	assert.NoError(t, err)
+	assert.NotEqual(t, exp.String(), res[1].String())
+}
+
+func Test_EMAOptions_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Options EMAOptions
+		Error   error
+	}{
+		"Invalid seed": {
+			Options: EMAOptions{Seed: 70},
+			Error:   ErrInvalidEMASeed,
+		},
+		"Negative alpha": {
+			Options: EMAOptions{Alpha: decimal.NewFromInt(-1)},
+			Error:   ErrInvalidFactor,
+		},
+		"Alpha exceeds 1": {
+			Options: EMAOptions{Alpha: decimal.RequireFromString("1.1")},
+			Error:   ErrInvalidFactor,
+		},
+		"Successful validation of default options": {},
+		"Successful validation": {
+			Options: EMAOptions{
+				Seed:  EMASeedValue,
+				Value: decimal.NewFromInt(10),
+				Alpha: decimal.RequireFromString("0.25"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Options.Validate())
+		})
+	}
+}
+
 func Test_NewEMA(t *testing.T) {
 	cc := map[string]struct {
 		Length int
@@ -455,29 +1279,92 @@ func Test_NewEMA(t *testing.T) {
 	}
 }
 
-func Test_EMA_Calc(t *testing.T) {
+func Test_NewEMAWithOptions(t *testing.T) {
 	cc := map[string]struct {
-		EMA    EMA
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		Length  int
+		Options EMAOptions
+		Result  EMA
+		Error   error
 	}{
-		"Invalid indicator": {
-			EMA:   EMA{},
-			Error: ErrInvalidIndicator,
+		"Invalid options": {
+			Length:  3,
+			Options: EMAOptions{Seed: 70},
+			Error:   ErrInvalidEMASeed,
 		},
-		"Invalid data size": {
-			EMA: EMA{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new EMA with first value seed": {
+			Length: 3,
+			Options: EMAOptions{
+				Seed:  EMASeedFirst,
+				Value: decimal.NewFromInt(10),
+			},
+			Result: EMA{
 				valid: true,
 				sma: SMA{
 					length: 3,
 					valid:  true,
 				},
+				seed: EMASeedFirst,
 			},
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(30),
-			},
-			Error: ErrInvalidDataSize,
+		},
+		"Successfully created new EMA with provided value seed": {
+			Length: 3,
+			Options: EMAOptions{
+				Seed:  EMASeedValue,
+				Value: decimal.NewFromInt(10),
+				Alpha: decimal.RequireFromString("0.25"),
+			},
+			Result: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+				seed:  EMASeedValue,
+				value: decimal.NewFromInt(10),
+				alpha: decimal.RequireFromString("0.25"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewEMAWithOptions(c.Length, c.Options)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_EMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		EMA    EMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			EMA:   EMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			EMA: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
 		},
 		"Successful calculation": {
 			EMA: EMA{
@@ -496,6 +1383,57 @@ func Test_EMA_Calc(t *testing.T) {
 			},
 			Result: decimal.RequireFromString("4.75"),
 		},
+		"Successful calculation with first value seed": {
+			EMA: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+				seed: EMASeedFirst,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+			},
+			Result: decimal.RequireFromString("8.5"),
+		},
+		"Successful calculation with provided value seed": {
+			EMA: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+				seed:  EMASeedValue,
+				value: decimal.NewFromInt(10),
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(6),
+			},
+			Result: decimal.RequireFromString("5.5"),
+		},
+		"Successful calculation with custom alpha": {
+			EMA: EMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+				alpha: decimal.RequireFromString("0.25"),
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.RequireFromString("7.3125"),
+		},
 	}
 
 	for cn, c := range cc {
@@ -566,6 +1504,250 @@ func Test_EMA_Count(t *testing.T) {
 			length: 15,
 		},
 	}.Count())
+
+	assert.Equal(t, 15, EMA{
+		sma: SMA{
+			length: 15,
+		},
+		seed: EMASeedFirst,
+	}.Count())
+
+	assert.Equal(t, 15, EMA{
+		sma: SMA{
+			length: 15,
+		},
+		seed: EMASeedValue,
+	}.Count())
+}
+
+func Test_NewEMAConstructor(t *testing.T) {
+	cc := map[string]struct {
+		Options EMAOptions
+		Error   error
+	}{
+		"Invalid options": {
+			Options: EMAOptions{Seed: 70},
+			Error:   ErrInvalidEMASeed,
+		},
+		"Provided value seed": {
+			Options: EMAOptions{Seed: EMASeedValue, Value: decimal.NewFromInt(10)},
+			Error:   ErrInvalidEMASeed,
+		},
+		"Successfully created new EMA constructor": {
+			Options: EMAOptions{Seed: EMASeedFirst, Alpha: decimal.RequireFromString("0.25")},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewEMAConstructor(c.Options)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				assert.Nil(t, res)
+				return
+			}
+
+			ma, err := res(3)
+			assert.NoError(t, err)
+
+			exp, err := NewEMAWithOptions(3, c.Options)
+			assert.NoError(t, err)
+			assert.Equal(t, exp, ma)
+		})
+	}
+}
+
+func Test_NewEMAConstructor_Registered(t *testing.T) {
+	constructor, err := NewEMAConstructor(EMAOptions{Seed: EMASeedFirst})
+	assert.NoError(t, err)
+
+	mat, err := RegisterMA("first-seeded-exponential", constructor)
+	assert.NoError(t, err)
+
+	t.Cleanup(func() {
+		unregisterMA(mat)
+	})
+
+	macd, err := NewMACD(2, 3, 2, mat)
+	assert.NoError(t, err)
+	assert.Equal(t, EMA{valid: true, sma: SMA{valid: true, length: 3}, seed: EMASeedFirst}, macd.slow)
+}
+
+func Test_EMA_chain(t *testing.T) {
+	dd := streamTestData()
+
+	cc := map[string]EMAOptions{
+		"Simple seed": {},
+		"First seed": {
+			Seed: EMASeedFirst,
+		},
+		"Provided value seed": {
+			Seed:  EMASeedValue,
+			Value: decimal.NewFromInt(50),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			ema, err := NewEMAWithOptions(3, c)
+			assert.NoError(t, err)
+
+			ee, err := ema.chain(dd, 2)
+			assert.NoError(t, err)
+
+			exp, err := ema.CalcContinuousSeries(dd)
+			assert.NoError(t, err)
+			assert.Equal(t, exp, ee[0][len(ee[0])-len(exp):])
+
+			seed, next, err := ema.start(dd)
+			assert.NoError(t, err)
+
+			// the second series smooths the first one and both of them
+			// are seeded with the same value.
+			curr := [2]decimal.Decimal{seed, seed}
+
+			for i := next; i < len(dd); i++ {
+				curr[0], err = ema.CalcNext(curr[0], dd[i])
+				assert.NoError(t, err)
+
+				curr[1], err = ema.CalcNext(curr[1], curr[0])
+				assert.NoError(t, err)
+			}
+
+			assert.Equal(t, curr[1], ee[1][len(ee[1])-1])
+		})
+	}
+}
+
+func Test_EMA_Stream(t *testing.T) {
+	_, err := EMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	_, err = EMA{valid: true, sma: SMA{valid: true, length: 3}, seed: EMASeedValue}.Stream()
+	assertEqualError(t, ErrInvalidEMASeed, err)
+
+	ind := EMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_EMA_CalcSeries(t *testing.T) {
+	_, err := EMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ema := EMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	_, err = ema.CalcSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ema.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ema.Count(), ema.Calc, streamTestData())
+
+	ema.seed = EMASeedFirst
+
+	res, err = ema.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ema.Count(), ema.Calc, streamTestData())
+
+	ema.seed = EMASeedValue
+	ema.value = decimal.NewFromInt(60)
+
+	res, err = ema.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ema.Count(), ema.Calc, streamTestData())
+}
+
+func Test_EMA_CalcContinuousSeries(t *testing.T) {
+	_, err := EMA{}.CalcContinuousSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ema := EMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	_, err = ema.CalcContinuousSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := []decimal.Decimal{
+		decimal.NewFromInt(31),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(3),
+		decimal.NewFromInt(5),
+	}
+
+	res, err := ema.CalcContinuousSeries(dd)
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "4.75", res[0].String())
+	assert.Equal(t, "4.875", res[1].String())
+
+	// smoothing continues over the whole series, so only the first value
+	// matches the one calculated from a separate window.
+	exp, err := ema.Calc(dd[:ema.Count()])
+	assert.NoError(t, err)
+	assert.Equal(t, exp.String(), res[0].String())
+
+	exp, err = ema.Calc(dd[1:])
+	assert.NoError(t, err)
+	assert.NotEqual(t, exp.String(), res[1].String())
+
+	ema.seed = EMASeedFirst
+
+	res, err = ema.CalcContinuousSeries([]decimal.Decimal{
+		decimal.NewFromInt(31),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(2),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "8.5", res[0].String())
+	assert.Equal(t, "5.25", res[1].String())
+
+	ema.seed = EMASeedValue
+	ema.value = decimal.NewFromInt(10)
+
+	res, err = ema.CalcContinuousSeries([]decimal.Decimal{
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(4),
+		decimal.NewFromInt(6),
+		decimal.NewFromInt(8),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "5.5", res[0].String())
+	assert.Equal(t, "6.75", res[1].String())
+
+	// EMA with Wilder's smoothing factor matches SMMA.
+	ema, err = NewEMAWithOptions(3, EMAOptions{
+		Alpha: _one.Div(decimal.NewFromInt(3)),
+	})
+	assert.NoError(t, err)
+
+	smma := SMMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	res, err = ema.CalcContinuousSeries(streamTestData())
+	assert.NoError(t, err)
+
+	sres, err := smma.CalcContinuousSeries(streamTestData())
+	assert.NoError(t, err)
+	assert.Len(t, res, len(sres))
+
+	for i := range res {
+		assert.Equal(t, sres[i].Round(8).String(), res[i].Round(8).String())
+	}
 }
 
 func Test_EMA_multiplier(t *testing.T) {
@@ -574,6 +1756,13 @@ func Test_EMA_multiplier(t *testing.T) {
 			length: 3,
 		},
 	}.multiplier().String())
+
+	assert.Equal(t, decimal.RequireFromString("0.25").String(), EMA{
+		sma: SMA{
+			length: 3,
+		},
+		alpha: decimal.RequireFromString("0.25"),
+	}.multiplier().String())
 }
 
 func Test_NewHMA(t *testing.T) {
@@ -679,20 +1868,50 @@ func Test_HMA_Count(t *testing.T) {
 	}.Count())
 }
 
-func Test_NewSMA(t *testing.T) {
+func Test_HMA_Stream(t *testing.T) {
+	_, err := HMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := HMA{valid: true, wma: WMA{valid: true, length: 4}}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_HMA_CalcSeries(t *testing.T) {
+	_, err := HMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := HMA{valid: true, wma: WMA{valid: true, length: 9}}
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_NewKAMA(t *testing.T) {
 	cc := map[string]struct {
 		Length int
-		Result SMA
+		Result KAMA
 		Error  error
 	}{
-		"Validate returns an error": {
-			Error: assert.AnError,
+		"Invalid length": {
+			Error: ErrInvalidLength,
 		},
-		"Successfully created new SMA": {
-			Length: 1,
-			Result: SMA{
-				valid:  true,
-				length: 1,
+		"Successfully created new KAMA": {
+			Length: 3,
+			Result: KAMA{
+				valid: true,
+				sma: SMA{
+					valid:  true,
+					length: 3,
+				},
+				fast: 2,
+				slow: 30,
 			},
 		},
 	}
@@ -703,28 +1922,35 @@ func Test_NewSMA(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := NewSMA(c.Length)
+			res, err := NewKAMA(c.Length)
 			assertEqualError(t, c.Error, err)
 			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_SMA_validate(t *testing.T) {
+func Test_KAMA_Calc(t *testing.T) {
+	kama, err := NewKAMA(3)
+	assert.NoError(t, err)
+
 	cc := map[string]struct {
-		SMA   SMA
-		Error error
+		KAMA   KAMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
 	}{
-		"Invalid length": {
-			SMA: SMA{
-				length: 0,
-			},
-			Error: ErrInvalidLength,
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
 		},
-		"Successfully validated": {
-			SMA: SMA{
-				length: 1,
-			},
+		"Invalid data size": {
+			KAMA:  kama,
+			Data:  streamTestData()[:4],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			KAMA:   kama,
+			Data:   streamTestData()[:5],
+			Result: decimal.RequireFromString("64.4777024"),
 		},
 	}
 
@@ -734,24 +1960,416 @@ func Test_SMA_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.SMA.validate())
+			res, err := c.KAMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
 
-			if c.Error == nil {
-				assert.True(t, c.SMA.valid)
+			if err != nil {
+				return
 			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
 		})
 	}
 }
 
-func Test_SMA_Calc(t *testing.T) {
+func Test_KAMA_Count(t *testing.T) {
+	assert.Equal(t, 5, KAMA{sma: SMA{length: 3}}.Count())
+}
+
+func Test_KAMA_CalcSeries(t *testing.T) {
+	_, err := KAMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	kama, err := NewKAMA(3)
+	assert.NoError(t, err)
+
+	_, err = kama.CalcSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := kama.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, kama.Count(), kama.Calc, streamTestData())
+}
+
+func Test_KAMA_CalcContinuousSeries(t *testing.T) {
+	_, err := KAMA{}.CalcContinuousSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	kama, err := NewKAMA(3)
+	assert.NoError(t, err)
+
+	_, err = kama.CalcContinuousSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := streamTestData()
+
+	res, err := kama.CalcContinuousSeries(dd)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(dd)-kama.Count()+1)
+
+	first, err := kama.Calc(dd[:kama.Count()])
+	assert.NoError(t, err)
+	assert.Equal(t, first.String(), res[0].String())
+}
+
+func Test_KAMA_Stream(t *testing.T) {
+	_, err := KAMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	kama, err := NewKAMA(3)
+	assert.NoError(t, err)
+
+	s, err := kama.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, kama.Count(), kama.Calc, streamTestData())
+}
+
+func Test_NewKC(t *testing.T) {
 	cc := map[string]struct {
-		SMA    SMA
-		Data   []decimal.Decimal
-		Result decimal.Decimal
-		Error  error
+		Type       MAType
+		Length     int
+		ATRLength  int
+		Multiplier decimal.Decimal
+		Result     KC
+		Error      error
 	}{
-		"Invalid indicator": {
-			SMA:   SMA{},
+		"Invalid provided moving average type": {
+			Length:     20,
+			ATRLength:  10,
+			Multiplier: decimal.NewFromInt(2),
+			Error:      ErrInvalidMA,
+		},
+		"Invalid ATR length": {
+			Type:       MATypeExponential,
+			Length:     20,
+			Multiplier: decimal.NewFromInt(2),
+			Error:      ErrInvalidLength,
+		},
+		"Validate returns an error": {
+			Type:      MATypeExponential,
+			Length:    20,
+			ATRLength: 10,
+			Error:     ErrInvalidFactor,
+		},
+		"Successfully created new KC": {
+			Type:       MATypeSimple,
+			Length:     20,
+			ATRLength:  10,
+			Multiplier: decimal.NewFromInt(2),
+			Result: KC{
+				valid:      true,
+				multiplier: decimal.NewFromInt(2),
+				ma:         SMA{valid: true, length: 20},
+				atr: ATR{
+					valid: true,
+					ma: SMMA{
+						valid: true,
+						sma:   SMA{valid: true, length: 10},
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewKC(c.Type, c.Length, c.ATRLength, c.Multiplier)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_KC_validate(t *testing.T) {
+	cc := map[string]struct {
+		KC    KC
+		Error error
+	}{
+		"Invalid multiplier": {
+			KC:    KC{},
+			Error: ErrInvalidFactor,
+		},
+		"Successfully validated": {
+			KC: KC{
+				multiplier: decimal.NewFromInt(2),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.KC.validate())
+
+			if c.Error == nil {
+				assert.True(t, c.KC.valid)
+			}
+		})
+	}
+}
+
+func Test_KC_Calc(t *testing.T) {
+	kc := KC{
+		valid:      true,
+		multiplier: decimal.NewFromInt(2),
+		ma:         SMA{valid: true, length: 3},
+		atr: ATR{
+			valid: true,
+			ma: SMMA{
+				valid: true,
+				sma:   SMA{valid: true, length: 2},
+			},
+		},
+	}
+
+	cc := map[string]struct {
+		KC           KC
+		Data         []Candle
+		UpperResult  decimal.Decimal
+		MiddleResult decimal.Decimal
+		LowerResult  decimal.Decimal
+		Error        error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			KC:    kc,
+			Data:  candleTestData()[:3],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			KC:           kc,
+			Data:         candleTestData()[:4],
+			UpperResult:  decimal.RequireFromString("66.1483333333333333"),
+			MiddleResult: decimal.RequireFromString("64.5433333333333333"),
+			LowerResult:  decimal.RequireFromString("62.9383333333333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			upper, middle, lower, err := c.KC.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.UpperResult.String(), upper.String())
+			assert.Equal(t, c.MiddleResult.String(), middle.String())
+			assert.Equal(t, c.LowerResult.String(), lower.String())
+		})
+	}
+}
+
+func Test_KC_CalcBand(t *testing.T) {
+	kc := KC{
+		valid:      true,
+		multiplier: decimal.NewFromInt(2),
+		ma:         SMA{valid: true, length: 3},
+		atr: ATR{
+			valid: true,
+			ma: SMMA{
+				valid: true,
+				sma:   SMA{valid: true, length: 2},
+			},
+		},
+	}
+
+	cc := map[string]struct {
+		KC     KC
+		Band   Band
+		Data   []Candle
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid band": {
+			KC:    kc,
+			Band:  BandWidth,
+			Data:  candleTestData()[:4],
+			Error: ErrInvalidBand,
+		},
+		"Invalid indicator": {
+			Band:  BandUpper,
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation with BandUpper": {
+			KC:     kc,
+			Band:   BandUpper,
+			Data:   candleTestData()[:4],
+			Result: decimal.RequireFromString("66.1483333333333333"),
+		},
+		"Successful calculation with BandMiddle": {
+			KC:     kc,
+			Band:   BandMiddle,
+			Data:   candleTestData()[:4],
+			Result: decimal.RequireFromString("64.5433333333333333"),
+		},
+		"Successful calculation with BandLower": {
+			KC:     kc,
+			Band:   BandLower,
+			Data:   candleTestData()[:4],
+			Result: decimal.RequireFromString("62.9383333333333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.KC.CalcBand(c.Data, c.Band)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_KC_Count(t *testing.T) {
+	kc, err := NewKC(MATypeExponential, 20, 10, decimal.NewFromInt(2))
+	assert.NoError(t, err)
+	assert.Equal(t, 39, kc.Count())
+
+	kc, err = NewKC(MATypeSimple, 5, 10, decimal.NewFromInt(2))
+	assert.NoError(t, err)
+	assert.Equal(t, 20, kc.Count())
+}
+
+func Test_KC_Stream(t *testing.T) {
+	_, err := KC{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	kc, err := NewKC(MATypeExponential, 3, 2, decimal.NewFromInt(2))
+	assert.NoError(t, err)
+
+	s, err := kc.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, kc.Count(), func(cc []Candle) (KCResult, error) {
+		upper, middle, lower, err := kc.Calc(cc)
+
+		return KCResult{Upper: upper, Middle: middle, Lower: lower}, err
+	}, candleTestData())
+}
+
+func Test_KC_CalcSeries(t *testing.T) {
+	_, err := KC{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	kc := KC{
+		valid:      true,
+		multiplier: decimal.NewFromInt(2),
+		ma:         SMA{valid: true, length: 5},
+		atr:        ATR{valid: true, ma: SMA{valid: true, length: 3}},
+	}
+
+	_, err = kc.CalcSeries(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := kc.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, kc.Count(), func(cc []Candle) (KCResult, error) {
+		upper, middle, lower, err := kc.Calc(cc)
+
+		return KCResult{Upper: upper, Middle: middle, Lower: lower}, err
+	}, candleTestData())
+
+	kc.atr = ATR{valid: true, ma: SMMA{valid: true, sma: SMA{valid: true, length: 3}}}
+
+	res, err = kc.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, kc.Count(), func(cc []Candle) (KCResult, error) {
+		upper, middle, lower, err := kc.Calc(cc)
+
+		return KCResult{Upper: upper, Middle: middle, Lower: lower}, err
+	}, candleTestData())
+}
+
+func Test_NewSMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result SMA
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new SMA": {
+			Length: 1,
+			Result: SMA{
+				valid:  true,
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SMA_validate(t *testing.T) {
+	cc := map[string]struct {
+		SMA   SMA
+		Error error
+	}{
+		"Invalid length": {
+			SMA: SMA{
+				length: 0,
+			},
+			Error: ErrInvalidLength,
+		},
+		"Successfully validated": {
+			SMA: SMA{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.SMA.validate())
+
+			if c.Error == nil {
+				assert.True(t, c.SMA.valid)
+			}
+		})
+	}
+}
+
+func Test_SMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		SMA    SMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			SMA:   SMA{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
@@ -774,7 +2392,1072 @@ func Test_SMA_Calc(t *testing.T) {
 				decimal.NewFromInt(31),
 				decimal.NewFromInt(32),
 			},
-			Result: decimal.NewFromInt(31),
+			Result: decimal.NewFromInt(31),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_SMA_Count(t *testing.T) {
+	assert.Equal(t, 15, SMA{
+		length: 15,
+	}.Count())
+}
+
+func Test_SMA_Stream(t *testing.T) {
+	_, err := SMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := SMA{valid: true, length: 3}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_SMA_CalcSeries(t *testing.T) {
+	_, err := SMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := SMA{valid: true, length: 3}
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_NewSMMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result SMMA
+		Error  error
+	}{
+		"Invalid parameters": {
+			Error: assert.AnError,
+		},
+		"Successfully created new SMMA": {
+			Length: 1,
+			Result: SMMA{
+				valid: true,
+				sma: SMA{
+					length: 1,
+					valid:  true,
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSMMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_SMMA_Calc(t *testing.T) {
+	cc := map[string]struct {
+		SMMA   SMMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			SMMA:  SMMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			SMMA: SMMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			SMMA: SMMA{
+				valid: true,
+				sma: SMA{
+					length: 3,
+					valid:  true,
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(31),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+			},
+			Result: decimal.RequireFromString("6.3333333333333333"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_SMMA_CalcNext(t *testing.T) {
+	cc := map[string]struct {
+		SMMA   SMMA
+		Last   decimal.Decimal
+		Next   decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			SMMA:  SMMA{},
+			Error: ErrInvalidIndicator,
+		},
+		"Successful calculation": {
+			SMMA: SMMA{
+				valid: true,
+				sma: SMA{
+					length: 4,
+					valid:  true,
+				},
+			},
+			Last:   decimal.NewFromInt(4),
+			Next:   decimal.NewFromInt(8),
+			Result: decimal.NewFromInt(5),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.SMMA.CalcNext(c.Last, c.Next)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_SMMA_Count(t *testing.T) {
+	assert.Equal(t, 29, SMMA{
+		sma: SMA{
+			length: 15,
+		},
+	}.Count())
+}
+
+func Test_SMMA_Stream(t *testing.T) {
+	_, err := SMMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := SMMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_SMMA_CalcSeries(t *testing.T) {
+	_, err := SMMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	smma := SMMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	_, err = smma.CalcSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := smma.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, smma.Count(), smma.Calc, streamTestData())
+}
+
+func Test_SMMA_CalcContinuousSeries(t *testing.T) {
+	_, err := SMMA{}.CalcContinuousSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	smma := SMMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	_, err = smma.CalcContinuousSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := smma.CalcContinuousSeries([]decimal.Decimal{
+		decimal.NewFromInt(31),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(2),
+		decimal.NewFromInt(3),
+		decimal.NewFromInt(5),
+	})
+	assert.NoError(t, err)
+	assert.Len(t, res, 2)
+	assert.Equal(t, "6.3333333333333333", res[0].String())
+	assert.Equal(t, "5.8888888888888889", res[1].String())
+}
+
+func Test_NewT3(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result T3
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new T3": {
+			Length: 3,
+			Result: T3{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 3,
+					},
+				},
+				factor: decimal.RequireFromString("0.7"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewT3(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_NewT3WithFactor(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Factor decimal.Decimal
+		Result T3
+		Error  error
+	}{
+		"Invalid length": {
+			Factor: decimal.RequireFromString("0.5"),
+			Error:  ErrInvalidLength,
+		},
+		"Invalid factor": {
+			Length: 3,
+			Factor: decimal.NewFromInt(-1),
+			Error:  ErrInvalidFactor,
+		},
+		"Successfully created new T3": {
+			Length: 3,
+			Factor: decimal.RequireFromString("0.5"),
+			Result: T3{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 3,
+					},
+				},
+				factor: decimal.RequireFromString("0.5"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewT3WithFactor(c.Length, c.Factor)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_T3_Calc(t *testing.T) {
+	t3, err := NewT3(3)
+	assert.NoError(t, err)
+
+	cc := map[string]struct {
+		T3     T3
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			T3:    t3,
+			Data:  streamTestData()[:4],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			T3:     t3,
+			Data:   streamTestData()[:5],
+			Result: decimal.RequireFromString("64.3383801"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.T3.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_T3_Count(t *testing.T) {
+	assert.Equal(t, 5, T3{ema: EMA{sma: SMA{length: 3}}}.Count())
+}
+
+func Test_T3_CalcSeries(t *testing.T) {
+	_, err := T3{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	t3, err := NewT3(3)
+	assert.NoError(t, err)
+
+	_, err = t3.CalcSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := t3.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, t3.Count(), t3.Calc, streamTestData())
+}
+
+func Test_T3_CalcContinuousSeries(t *testing.T) {
+	_, err := T3{}.CalcContinuousSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	t3, err := NewT3(3)
+	assert.NoError(t, err)
+
+	_, err = t3.CalcContinuousSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := streamTestData()
+
+	res, err := t3.CalcContinuousSeries(dd)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(dd)-t3.Count()+1)
+
+	first, err := t3.Calc(dd[:t3.Count()])
+	assert.NoError(t, err)
+	assert.Equal(t, first.String(), res[0].String())
+}
+
+func Test_T3_Stream(t *testing.T) {
+	_, err := T3{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	t3, err := NewT3(3)
+	assert.NoError(t, err)
+
+	s, err := t3.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, t3.Count(), t3.Calc, streamTestData())
+}
+
+func Test_NewTEMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result TEMA
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new TEMA": {
+			Length: 3,
+			Result: TEMA{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 3,
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewTEMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_TEMA_Calc(t *testing.T) {
+	tema, err := NewTEMA(3)
+	assert.NoError(t, err)
+
+	cc := map[string]struct {
+		TEMA   TEMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			TEMA:  tema,
+			Data:  streamTestData()[:4],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			TEMA:   tema,
+			Data:   streamTestData()[:5],
+			Result: decimal.RequireFromString("64.07020833"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.TEMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_TEMA_Count(t *testing.T) {
+	assert.Equal(t, 5, TEMA{ema: EMA{sma: SMA{length: 3}}}.Count())
+}
+
+func Test_TEMA_CalcSeries(t *testing.T) {
+	_, err := TEMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	tema, err := NewTEMA(3)
+	assert.NoError(t, err)
+
+	_, err = tema.CalcSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := tema.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, tema.Count(), tema.Calc, streamTestData())
+}
+
+func Test_TEMA_CalcContinuousSeries(t *testing.T) {
+	_, err := TEMA{}.CalcContinuousSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	tema, err := NewTEMA(3)
+	assert.NoError(t, err)
+
+	_, err = tema.CalcContinuousSeries(streamTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := streamTestData()
+
+	res, err := tema.CalcContinuousSeries(dd)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(dd)-tema.Count()+1)
+
+	first, err := tema.Calc(dd[:tema.Count()])
+	assert.NoError(t, err)
+	assert.Equal(t, first.String(), res[0].String())
+}
+
+func Test_TEMA_Stream(t *testing.T) {
+	_, err := TEMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	tema, err := NewTEMA(3)
+	assert.NoError(t, err)
+
+	s, err := tema.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, tema.Count(), tema.Calc, streamTestData())
+}
+
+func Test_NewVWAP(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result VWAP
+		Error  error
+	}{
+		"Validate returns an error": {
+			Error: assert.AnError,
+		},
+		"Successfully created new VWAP": {
+			Length: 1,
+			Result: VWAP{
+				valid:  true,
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewVWAP(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_VWAP_validate(t *testing.T) {
+	cc := map[string]struct {
+		VWAP  VWAP
+		Error error
+	}{
+		"Invalid length": {
+			VWAP: VWAP{
+				length: 0,
+			},
+			Error: ErrInvalidLength,
+		},
+		"Successfully validated": {
+			VWAP: VWAP{
+				length: 1,
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.VWAP.validate())
+
+			if c.Error == nil {
+				assert.True(t, c.VWAP.valid)
+			}
+		})
+	}
+}
+
+func Test_VWAP_Calc(t *testing.T) {
+	cc := map[string]struct {
+		VWAP    VWAP
+		Prices  []decimal.Decimal
+		Volumes []decimal.Decimal
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			VWAP:  VWAP{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			VWAP: VWAP{
+				valid:  true,
+				length: 3,
+			},
+			Prices: []decimal.Decimal{
+				decimal.NewFromInt(30),
+			},
+			Volumes: []decimal.Decimal{
+				decimal.NewFromInt(100),
+			},
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation with zero volume": {
+			VWAP: VWAP{
+				valid:  true,
+				length: 8,
+			},
+			Prices: []decimal.Decimal{
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.72),
+			},
+			Volumes: []decimal.Decimal{
+				decimal.NewFromInt(0),
+				decimal.NewFromInt(0),
+				decimal.NewFromInt(0),
+				decimal.NewFromInt(0),
+				decimal.NewFromInt(0),
+				decimal.NewFromInt(0),
+				decimal.NewFromInt(0),
+				decimal.NewFromInt(0),
+			},
+			Result: decimal.RequireFromString("81.72"),
+		},
+		"Successful calculation": {
+			VWAP: VWAP{
+				valid:  true,
+				length: 8,
+			},
+			Prices: []decimal.Decimal{
+				decimal.NewFromFloat(81.72),
+				decimal.NewFromFloat(81.75),
+				decimal.NewFromFloat(81.73),
+				decimal.NewFromFloat(81.76),
+				decimal.NewFromFloat(81.77),
+				decimal.NewFromFloat(81.77),
+				decimal.NewFromFloat(81.76),
+				decimal.NewFromFloat(81.78),
+			},
+			Volumes: []decimal.Decimal{
+				decimal.NewFromInt(34250),
+				decimal.NewFromInt(37800),
+				decimal.NewFromInt(41410),
+				decimal.NewFromInt(36555),
+				decimal.NewFromInt(32100),
+				decimal.NewFromInt(25730),
+				decimal.NewFromInt(18500),
+				decimal.NewFromInt(17615),
+			},
+			Result: decimal.RequireFromString("81.7515572224954911"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.VWAP.Calc(c.Prices, c.Volumes)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_VWAP_CalcCandles(t *testing.T) {
+	cc := map[string]struct {
+		VWAP    VWAP
+		Candles []Candle
+		Result  decimal.Decimal
+		Error   error
+	}{
+		"Invalid indicator": {
+			VWAP:  VWAP{},
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			VWAP: VWAP{
+				valid:  true,
+				length: 2,
+			},
+			Candles: []Candle{{}},
+			Error:   ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			VWAP: VWAP{
+				valid:  true,
+				length: 2,
+			},
+			Candles: []Candle{
+				{
+					High:   decimal.NewFromInt(12),
+					Low:    decimal.NewFromInt(8),
+					Close:  decimal.NewFromInt(10),
+					Volume: decimal.NewFromInt(1),
+				},
+				{
+					High:   decimal.NewFromInt(22),
+					Low:    decimal.NewFromInt(18),
+					Close:  decimal.NewFromInt(20),
+					Volume: decimal.NewFromInt(3),
+				},
+			},
+			Result: decimal.RequireFromString("17.5"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.VWAP.CalcCandles(c.Candles)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_VWAP_Count(t *testing.T) {
+	assert.Equal(t, 15, VWAP{
+		length: 15,
+	}.Count())
+}
+
+func Test_VWAP_Stream(t *testing.T) {
+	_, err := VWAP{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	vwap := VWAP{valid: true, length: 2}
+
+	s, err := vwap.Stream()
+	assert.NoError(t, err)
+	assert.False(t, s.Ready())
+
+	res, ok, err := s.Update(decimal.NewFromInt(10), decimal.NewFromInt(1))
+	assert.NoError(t, err)
+	assert.False(t, ok)
+	assert.Equal(t, decimal.Zero.String(), res.String())
+
+	res, ok, err = s.Update(decimal.NewFromInt(20), decimal.NewFromInt(3))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "17.5", res.String())
+
+	res, ok, err = s.Update(decimal.NewFromInt(30), decimal.NewFromInt(1))
+	assert.NoError(t, err)
+	assert.True(t, ok)
+	assert.Equal(t, "22.5", res.String())
+	assert.True(t, s.Ready())
+
+	s.Reset()
+	assert.False(t, s.Ready())
+
+	_, _, err = (&VWAPStream{}).Update(decimal.Zero, decimal.Zero)
+	assertEqualError(t, ErrInvalidIndicator, err)
+	assert.False(t, (&VWAPStream{}).Ready())
+	(&VWAPStream{}).Reset()
+}
+
+func Test_VWAP_CalcSeries(t *testing.T) {
+	dd := []decimal.Decimal{
+		decimal.NewFromInt(10),
+		decimal.NewFromInt(20),
+		decimal.NewFromInt(30),
+		decimal.NewFromInt(40),
+	}
+
+	vv := []decimal.Decimal{
+		decimal.NewFromInt(1),
+		decimal.NewFromInt(3),
+		decimal.NewFromInt(0),
+		decimal.NewFromInt(0),
+	}
+
+	_, err := VWAP{}.CalcSeries(dd, vv)
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	vwap := VWAP{valid: true, length: 2}
+
+	_, err = vwap.CalcSeries(dd, vv[:3])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := vwap.CalcSeries(dd, vv)
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+
+	for i := range res {
+		exp, err := vwap.Calc(dd[i:i+2], vv[i:i+2])
+		assert.NoError(t, err)
+		assert.Equal(t, exp.String(), res[i].String())
+	}
+}
+
+func Test_Session_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Session Session
+		Error   error
+	}{
+		"Negative start": {
+			Session: Session{Start: -time.Minute},
+			Error:   ErrInvalidSession,
+		},
+		"Start exceeds a day": {
+			Session: Session{Start: 24 * time.Hour},
+			Error:   ErrInvalidSession,
+		},
+		"Successful validation": {
+			Session: Session{Start: 9*time.Hour + 30*time.Minute},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assertEqualError(t, c.Error, c.Session.Validate())
+		})
+	}
+}
+
+func Test_Session_StartOf(t *testing.T) {
+	est := time.FixedZone("EST", -5*60*60)
+
+	nyc, err := time.LoadLocation("America/New_York")
+	assert.NoError(t, err)
+
+	cc := map[string]struct {
+		Session Session
+		Time    time.Time
+		Result  time.Time
+	}{
+		"UTC is used by default": {
+			Session: Session{Start: time.Hour},
+			Time:    time.Date(2023, 1, 2, 10, 0, 0, 0, time.UTC),
+			Result:  time.Date(2023, 1, 2, 1, 0, 0, 0, time.UTC),
+		},
+		"Time at the opening of the session": {
+			Session: Session{Start: 9*time.Hour + 30*time.Minute, Location: est},
+			Time:    time.Date(2023, 1, 2, 9, 30, 0, 0, est),
+			Result:  time.Date(2023, 1, 2, 9, 30, 0, 0, est),
+		},
+		"Time before the opening of the session": {
+			Session: Session{Start: 9*time.Hour + 30*time.Minute, Location: est},
+			Time:    time.Date(2023, 1, 2, 14, 0, 0, 0, time.UTC),
+			Result:  time.Date(2023, 1, 1, 9, 30, 0, 0, est),
+		},
+		"Time on the day daylight saving time starts": {
+			Session: Session{Start: 9*time.Hour + 30*time.Minute, Location: nyc},
+			Time:    time.Date(2023, 3, 12, 10, 0, 0, 0, nyc),
+			Result:  time.Date(2023, 3, 12, 9, 30, 0, 0, nyc),
+		},
+		"Time on the day daylight saving time ends": {
+			Session: Session{Start: 9*time.Hour + 30*time.Minute, Location: nyc},
+			Time:    time.Date(2023, 11, 5, 9, 0, 0, 0, nyc),
+			Result:  time.Date(2023, 11, 4, 9, 30, 0, 0, nyc),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.True(t, c.Result.Equal(c.Session.StartOf(c.Time)))
+		})
+	}
+}
+
+func Test_NewAnchoredVWAP(t *testing.T) {
+	res, err := NewAnchoredVWAP()
+	assert.NoError(t, err)
+	assert.Equal(t, AnchoredVWAP{valid: true}, res)
+}
+
+func Test_NewAnchoredVWAPAt(t *testing.T) {
+	anchor := time.Date(2023, 1, 2, 15, 0, 0, 0, time.UTC)
+
+	res, err := NewAnchoredVWAPAt(anchor)
+	assert.NoError(t, err)
+	assert.Equal(t, AnchoredVWAP{valid: true, anchor: anchor}, res)
+}
+
+func Test_NewSessionVWAP(t *testing.T) {
+	cc := map[string]struct {
+		Session Session
+		Result  AnchoredVWAP
+		Error   error
+	}{
+		"Invalid session": {
+			Session: Session{Start: -time.Hour},
+			Error:   ErrInvalidSession,
+		},
+		"Successfully created new session VWAP": {
+			Session: Session{Start: time.Hour},
+			Result: AnchoredVWAP{
+				valid:   true,
+				reset:   true,
+				session: Session{Start: time.Hour},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewSessionVWAP(c.Session)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+// anchoredVWAPTestData returns candles of two sessions opening at 9:30
+// UTC, typical prices of the candles are equal to their closing prices.
+func anchoredVWAPTestData() []Candle {
+	candle := func(t time.Time, price, volume int64) Candle {
+		return Candle{
+			Time:     t,
+			Interval: time.Hour,
+			High:     decimal.NewFromInt(price),
+			Low:      decimal.NewFromInt(price),
+			Close:    decimal.NewFromInt(price),
+			Volume:   decimal.NewFromInt(volume),
+		}
+	}
+
+	return []Candle{
+		candle(time.Date(2023, 1, 2, 14, 0, 0, 0, time.UTC), 10, 1),
+		candle(time.Date(2023, 1, 2, 15, 0, 0, 0, time.UTC), 20, 1),
+		candle(time.Date(2023, 1, 3, 9, 0, 0, 0, time.UTC), 40, 2),
+		candle(time.Date(2023, 1, 3, 9, 30, 0, 0, time.UTC), 30, 0),
+		candle(time.Date(2023, 1, 3, 10, 30, 0, 0, time.UTC), 50, 3),
+	}
+}
+
+func Test_AnchoredVWAP_Calc(t *testing.T) {
+	cc := map[string]struct {
+		AnchoredVWAP AnchoredVWAP
+		Data         []Candle
+		Result       AnchoredVWAPResult
+		Error        error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			AnchoredVWAP: AnchoredVWAP{valid: true},
+			Error:        ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			AnchoredVWAP: AnchoredVWAP{valid: true},
+			Data:         anchoredVWAPTestData()[:2],
+			Result: AnchoredVWAPResult{
+				VWAP:   decimal.NewFromInt(15),
+				StdDev: decimal.NewFromInt(5),
+				Upper1: decimal.NewFromInt(20),
+				Lower1: decimal.NewFromInt(10),
+				Upper2: decimal.NewFromInt(25),
+				Lower2: decimal.NewFromInt(5),
+				Upper3: decimal.NewFromInt(30),
+				Lower3: decimal.NewFromInt(0),
+			},
+		},
+		"Successful calculation without volume": {
+			AnchoredVWAP: AnchoredVWAP{
+				valid:   true,
+				reset:   true,
+				session: Session{Start: 9*time.Hour + 30*time.Minute},
+			},
+			Data: anchoredVWAPTestData()[:4],
+			Result: AnchoredVWAPResult{
+				VWAP:   decimal.NewFromInt(30),
+				Upper1: decimal.NewFromInt(30),
+				Lower1: decimal.NewFromInt(30),
+				Upper2: decimal.NewFromInt(30),
+				Lower2: decimal.NewFromInt(30),
+				Upper3: decimal.NewFromInt(30),
+				Lower3: decimal.NewFromInt(30),
+			},
+		},
+		"Successful calculation with session reset": {
+			AnchoredVWAP: AnchoredVWAP{
+				valid:   true,
+				reset:   true,
+				session: Session{Start: 9*time.Hour + 30*time.Minute},
+			},
+			Data: anchoredVWAPTestData(),
+			Result: AnchoredVWAPResult{
+				VWAP:   decimal.NewFromInt(50),
+				Upper1: decimal.NewFromInt(50),
+				Lower1: decimal.NewFromInt(50),
+				Upper2: decimal.NewFromInt(50),
+				Lower2: decimal.NewFromInt(50),
+				Upper3: decimal.NewFromInt(50),
+				Lower3: decimal.NewFromInt(50),
+			},
 		},
 	}
 
@@ -784,69 +3467,141 @@ func Test_SMA_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.SMA.Calc(c.Data)
+			res, err := c.AnchoredVWAP.Calc(c.Data)
 			assertEqualError(t, c.Error, err)
-
-			if err != nil {
-				return
-			}
-
-			assert.Equal(t, c.Result.String(), res.String())
+			assert.Equal(t, c.Result.VWAP.String(), res.VWAP.String())
+			assert.Equal(t, c.Result.StdDev.String(), res.StdDev.String())
+			assert.Equal(t, c.Result.Upper1.String(), res.Upper1.String())
+			assert.Equal(t, c.Result.Lower1.String(), res.Lower1.String())
+			assert.Equal(t, c.Result.Upper2.String(), res.Upper2.String())
+			assert.Equal(t, c.Result.Lower2.String(), res.Lower2.String())
+			assert.Equal(t, c.Result.Upper3.String(), res.Upper3.String())
+			assert.Equal(t, c.Result.Lower3.String(), res.Lower3.String())
 		})
 	}
 }
 
-func Test_SMA_Count(t *testing.T) {
-	assert.Equal(t, 15, SMA{
-		length: 15,
-	}.Count())
+func Test_AnchoredVWAP_Count(t *testing.T) {
+	assert.Equal(t, 1, AnchoredVWAP{}.Count())
 }
 
-func Test_NewVWAP(t *testing.T) {
-	cc := map[string]struct {
-		Length int
-		Result VWAP
-		Error  error
-	}{
-		"Validate returns an error": {
-			Error: assert.AnError,
-		},
-		"Successfully created new VWAP": {
-			Length: 1,
-			Result: VWAP{
-				valid:  true,
-				length: 1,
-			},
-		},
+func Test_AnchoredVWAP_CalcSeries(t *testing.T) {
+	_, err := AnchoredVWAP{}.CalcSeries(anchoredVWAPTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	av := AnchoredVWAP{
+		valid:   true,
+		reset:   true,
+		session: Session{Start: 9*time.Hour + 30*time.Minute},
 	}
 
-	for cn, c := range cc {
-		c := c
+	_, err = av.CalcSeries(nil)
+	assertEqualError(t, ErrInvalidDataSize, err)
 
-		t.Run(cn, func(t *testing.T) {
-			t.Parallel()
+	res, err := av.CalcSeries(anchoredVWAPTestData())
+	assert.NoError(t, err)
+	assert.Len(t, res, 5)
 
-			res, err := NewVWAP(c.Length)
-			assertEqualError(t, c.Error, err)
-			assert.Equal(t, c.Result, res)
-		})
+	// the candle at 9:00 still belongs to the first session.
+	assert.Equal(t, "27.5", res[2].VWAP.String())
+	assert.Equal(t, "30", res[3].VWAP.String())
+	assert.Equal(t, "50", res[4].VWAP.String())
+
+	av.reset = false
+
+	res, err = av.CalcSeries(anchoredVWAPTestData())
+	assert.NoError(t, err)
+	assert.Equal(t, "37.1428571428571429", res[4].VWAP.String())
+
+	av.anchor = time.Date(2023, 1, 3, 9, 0, 0, 0, time.UTC)
+
+	res, err = av.CalcSeries(anchoredVWAPTestData())
+	assert.NoError(t, err)
+	assert.Len(t, res, 3)
+	assert.Equal(t, "40", res[0].VWAP.String())
+	assert.Equal(t, "40", res[1].VWAP.String())
+	assert.Equal(t, "46", res[2].VWAP.String())
+
+	av.anchor = time.Date(2023, 1, 4, 0, 0, 0, 0, time.UTC)
+
+	_, err = av.CalcSeries(anchoredVWAPTestData())
+	assertEqualError(t, ErrInvalidDataSize, err)
+}
+
+func Test_AnchoredVWAP_Stream(t *testing.T) {
+	_, err := AnchoredVWAP{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	_, _, err = (&AnchoredVWAPStream{}).Update(anchoredVWAPTestData()[0])
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	av := AnchoredVWAP{
+		valid:   true,
+		reset:   true,
+		session: Session{Start: 9*time.Hour + 30*time.Minute},
+	}
+
+	s, err := av.Stream()
+	assert.NoError(t, err)
+	assert.False(t, s.Ready())
+
+	exp, err := av.CalcSeries(anchoredVWAPTestData())
+	assert.NoError(t, err)
+
+	for i, c := range anchoredVWAPTestData() {
+		res, ready, err := s.Update(c)
+		assert.NoError(t, err)
+		assert.True(t, ready)
+		assert.Equal(t, exp[i], res)
+	}
+
+	assert.True(t, s.Ready())
+
+	s.Reset()
+	assert.False(t, s.Ready())
+
+	res, _, err := s.Update(anchoredVWAPTestData()[1])
+	assert.NoError(t, err)
+	assert.Equal(t, "20", res.VWAP.String())
+
+	av.anchor = time.Date(2023, 1, 3, 9, 0, 0, 0, time.UTC)
+
+	s, err = av.Stream()
+	assert.NoError(t, err)
+
+	exp, err = av.CalcSeries(anchoredVWAPTestData())
+	assert.NoError(t, err)
+
+	for i, c := range anchoredVWAPTestData() {
+		res, ready, err := s.Update(c)
+		assert.NoError(t, err)
+
+		if i < 2 {
+			assert.False(t, ready)
+			assert.Equal(t, AnchoredVWAPResult{}, res)
+
+			continue
+		}
+
+		assert.True(t, ready)
+		assert.Equal(t, exp[i-2], res)
 	}
 }
 
-func Test_VWAP_validate(t *testing.T) {
+func Test_NewVWMA(t *testing.T) {
 	cc := map[string]struct {
-		VWAP  VWAP
-		Error error
+		Length int
+		Result VWMA
+		Error  error
 	}{
 		"Invalid length": {
-			VWAP: VWAP{
-				length: 0,
-			},
 			Error: ErrInvalidLength,
 		},
-		"Successfully validated": {
-			VWAP: VWAP{
-				length: 1,
+		"Successfully created new VWMA": {
+			Length: 3,
+			Result: VWMA{
+				valid: true,
+				vwap:  VWAP{valid: true, length: 3},
 			},
 		},
 	}
@@ -857,93 +3612,52 @@ func Test_VWAP_validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			assertEqualError(t, c.Error, c.VWAP.validate())
-
-			if c.Error == nil {
-				assert.True(t, c.VWAP.valid)
-			}
+			res, err := NewVWMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
 		})
 	}
 }
 
-func Test_VWAP_Calc(t *testing.T) {
+func Test_VWMA_Calc(t *testing.T) {
 	cc := map[string]struct {
-		VWAP    VWAP
-		Prices  []decimal.Decimal
-		Volumes []decimal.Decimal
+		VWMA    VWMA
+		Candles []Candle
 		Result  decimal.Decimal
 		Error   error
 	}{
 		"Invalid indicator": {
-			VWAP:  VWAP{},
+			VWMA:  VWMA{},
 			Error: ErrInvalidIndicator,
 		},
 		"Invalid data size": {
-			VWAP: VWAP{
-				valid:  true,
-				length: 3,
-			},
-			Prices: []decimal.Decimal{
-				decimal.NewFromInt(30),
-			},
-			Volumes: []decimal.Decimal{
-				decimal.NewFromInt(100),
-			},
-			Error: ErrInvalidDataSize,
-		},
-		"Successful calculation with zero volume": {
-			VWAP: VWAP{
-				valid:  true,
-				length: 8,
-			},
-			Prices: []decimal.Decimal{
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.72),
-			},
-			Volumes: []decimal.Decimal{
-				decimal.NewFromInt(0),
-				decimal.NewFromInt(0),
-				decimal.NewFromInt(0),
-				decimal.NewFromInt(0),
-				decimal.NewFromInt(0),
-				decimal.NewFromInt(0),
-				decimal.NewFromInt(0),
-				decimal.NewFromInt(0),
+			VWMA: VWMA{
+				valid: true,
+				vwap:  VWAP{valid: true, length: 2},
 			},
-			Result: decimal.RequireFromString("81.72"),
+			Candles: []Candle{{}},
+			Error:   ErrInvalidDataSize,
 		},
 		"Successful calculation": {
-			VWAP: VWAP{
-				valid:  true,
-				length: 8,
-			},
-			Prices: []decimal.Decimal{
-				decimal.NewFromFloat(81.72),
-				decimal.NewFromFloat(81.75),
-				decimal.NewFromFloat(81.73),
-				decimal.NewFromFloat(81.76),
-				decimal.NewFromFloat(81.77),
-				decimal.NewFromFloat(81.77),
-				decimal.NewFromFloat(81.76),
-				decimal.NewFromFloat(81.78),
+			VWMA: VWMA{
+				valid: true,
+				vwap:  VWAP{valid: true, length: 2},
 			},
-			Volumes: []decimal.Decimal{
-				decimal.NewFromInt(34250),
-				decimal.NewFromInt(37800),
-				decimal.NewFromInt(41410),
-				decimal.NewFromInt(36555),
-				decimal.NewFromInt(32100),
-				decimal.NewFromInt(25730),
-				decimal.NewFromInt(18500),
-				decimal.NewFromInt(17615),
+			Candles: []Candle{
+				{
+					High:   decimal.NewFromInt(14),
+					Low:    decimal.NewFromInt(8),
+					Close:  decimal.NewFromInt(10),
+					Volume: decimal.NewFromInt(1),
+				},
+				{
+					High:   decimal.NewFromInt(24),
+					Low:    decimal.NewFromInt(18),
+					Close:  decimal.NewFromInt(20),
+					Volume: decimal.NewFromInt(3),
+				},
 			},
-			Result: decimal.RequireFromString("81.7515572224954911"),
+			Result: decimal.RequireFromString("17.5"),
 		},
 	}
 
@@ -953,7 +3667,7 @@ func Test_VWAP_Calc(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.VWAP.Calc(c.Prices, c.Volumes)
+			res, err := c.VWMA.Calc(c.Candles)
 			assertEqualError(t, c.Error, err)
 
 			if err != nil {
@@ -965,12 +3679,37 @@ func Test_VWAP_Calc(t *testing.T) {
 	}
 }
 
-func Test_VWAP_Count(t *testing.T) {
-	assert.Equal(t, 15, VWAP{
-		length: 15,
+func Test_VWMA_Count(t *testing.T) {
+	assert.Equal(t, 15, VWMA{
+		vwap: VWAP{length: 15},
 	}.Count())
 }
 
+func Test_VWMA_Stream(t *testing.T) {
+	_, err := VWMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	vwma := VWMA{valid: true, vwap: VWAP{valid: true, length: 5}}
+
+	s, err := vwma.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, vwma.Count(), vwma.Calc, candleTestData())
+}
+
+func Test_VWMA_CalcSeries(t *testing.T) {
+	_, err := VWMA{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	vwma := VWMA{valid: true, vwap: VWAP{valid: true, length: 5}}
+
+	_, err = vwma.CalcSeries(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := vwma.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, vwma.Count(), vwma.Calc, candleTestData())
+}
+
 func Test_NewWMA(t *testing.T) {
 	cc := map[string]struct {
 		Length int
@@ -1093,3 +3832,160 @@ func Test_WMA_Count(t *testing.T) {
 		length: 15,
 	}.Count())
 }
+
+func Test_WMA_Stream(t *testing.T) {
+	_, err := WMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := WMA{valid: true, length: 3}
+
+	s, err := ind.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_WMA_CalcSeries(t *testing.T) {
+	_, err := WMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	ind := WMA{valid: true, length: 4}
+
+	_, err = ind.CalcSeries(streamTestData()[:ind.Count()-1])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := ind.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, ind.Count(), ind.Calc, streamTestData())
+}
+
+func Test_NewZLEMA(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ZLEMA
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new ZLEMA": {
+			Length: 3,
+			Result: ZLEMA{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 3,
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewZLEMA(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ZLEMA_Calc(t *testing.T) {
+	zlema, err := NewZLEMA(3)
+	assert.NoError(t, err)
+
+	cc := map[string]struct {
+		ZLEMA  ZLEMA
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ZLEMA: zlema,
+			Data:  streamTestData()[:5],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ZLEMA:  zlema,
+			Data:   streamTestData()[:6],
+			Result: decimal.RequireFromString("63.8325"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ZLEMA.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ZLEMA_Count(t *testing.T) {
+	assert.Equal(t, 6, ZLEMA{ema: EMA{sma: SMA{length: 3}}}.Count())
+}
+
+func Test_ZLEMA_CalcSeries(t *testing.T) {
+	_, err := ZLEMA{}.CalcSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	zlema, err := NewZLEMA(3)
+	assert.NoError(t, err)
+
+	_, err = zlema.CalcSeries(streamTestData()[:5])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := zlema.CalcSeries(streamTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, zlema.Count(), zlema.Calc, streamTestData())
+}
+
+func Test_ZLEMA_CalcContinuousSeries(t *testing.T) {
+	_, err := ZLEMA{}.CalcContinuousSeries(streamTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	zlema, err := NewZLEMA(3)
+	assert.NoError(t, err)
+
+	_, err = zlema.CalcContinuousSeries(streamTestData()[:5])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	dd := streamTestData()
+
+	res, err := zlema.CalcContinuousSeries(dd)
+	assert.NoError(t, err)
+	assert.Len(t, res, len(dd)-zlema.Count()+1)
+
+	first, err := zlema.Calc(dd[:zlema.Count()])
+	assert.NoError(t, err)
+	assert.Equal(t, first.String(), res[0].String())
+}
+
+func Test_ZLEMA_Stream(t *testing.T) {
+	_, err := ZLEMA{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	zlema, err := NewZLEMA(3)
+	assert.NoError(t, err)
+
+	s, err := zlema.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, zlema.Count(), zlema.Calc, streamTestData())
+}
@@ -0,0 +1,92 @@
+package tango
+
+// Stream holds the most recent data points required by an indicator and
+// recalculates the indicator each time a new data point is added.
+// The zero value is not usable.
+type Stream[I, O any] struct {
+	// valid specifies whether Stream paremeters were validated.
+	valid bool
+
+	// count specifies how many data points should be used
+	// during the calculations.
+	count int
+
+	// window holds the most recent data points, the oldest being first.
+	window []I
+
+	// calc calculates indicator value from the data points window.
+	calc func([]I) (O, error)
+}
+
+// NewStream validates provided configuration options and creates new
+// Stream that passes the most recent count data points to the calc
+// function.
+func NewStream[I, O any](count int, calc func([]I) (O, error)) (*Stream[I, O], error) {
+	s := &Stream[I, O]{
+		count:  count,
+		window: make([]I, 0, count),
+		calc:   calc,
+	}
+
+	if err := s.validate(); err != nil {
+		return nil, err
+	}
+
+	return s, nil
+}
+
+// validate checks whether the stream has valid configuration properties.
+func (s *Stream[I, O]) validate() error {
+	if s.count < 1 {
+		return ErrInvalidLength
+	}
+
+	if s.calc == nil {
+		return ErrInvalidIndicator
+	}
+
+	s.valid = true
+
+	return nil
+}
+
+// Update adds a new data point to the stream and calculates indicator
+// value from the most recent data points. The returned boolean reports
+// whether the stream has collected enough data points (warm-up is
+// complete); until then the zero value is returned.
+func (s *Stream[I, O]) Update(v I) (O, bool, error) {
+	var res O
+
+	if !s.valid {
+		return res, false, ErrInvalidIndicator
+	}
+
+	if len(s.window) < s.count {
+		s.window = append(s.window, v)
+	} else {
+		copy(s.window, s.window[1:])
+		s.window[s.count-1] = v
+	}
+
+	if len(s.window) < s.count {
+		return res, false, nil
+	}
+
+	res, err := s.calc(s.window)
+	if err != nil {
+		return res, false, err
+	}
+
+	return res, true, nil
+}
+
+// Ready determines whether the stream has collected enough data points
+// for the calculation.
+func (s *Stream[I, O]) Ready() bool {
+	return s.valid && len(s.window) == s.count
+}
+
+// Reset removes all collected data points from the stream.
+func (s *Stream[I, O]) Reset() {
+	s.window = s.window[:0]
+}
@@ -0,0 +1,261 @@
+package tango
+
+import (
+	"testing"
+	"time"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+// assertStreamMatchesCalc checks whether the stream produces the same
+// values as calc does over every window of the provided data points.
+func assertStreamMatchesCalc[I, O any](
+	t *testing.T,
+	s *Stream[I, O],
+	count int,
+	calc func([]I) (O, error),
+	dd []I,
+) {
+
+	t.Helper()
+
+	for i := range dd {
+		res, ok, err := s.Update(dd[i])
+		assert.NoError(t, err)
+
+		if i+1 < count {
+			assert.False(t, ok)
+			continue
+		}
+
+		exp, err := calc(dd[i+1-count : i+1])
+		assert.NoError(t, err)
+		assert.True(t, ok)
+		assert.Equal(t, exp, res)
+	}
+}
+
+// streamTestData returns data points used to verify streams and
+// series calculations.
+func streamTestData() []decimal.Decimal {
+	return []decimal.Decimal{
+		decimal.RequireFromString("63.98"),
+		decimal.RequireFromString("64.17"),
+		decimal.RequireFromString("64.71"),
+		decimal.RequireFromString("64.75"),
+		decimal.RequireFromString("63.94"),
+		decimal.RequireFromString("63.82"),
+		decimal.RequireFromString("63.19"),
+		decimal.RequireFromString("62.84"),
+		decimal.RequireFromString("62.25"),
+		decimal.RequireFromString("63.20"),
+		decimal.RequireFromString("63.02"),
+		decimal.RequireFromString("63.35"),
+		decimal.RequireFromString("64.21"),
+		decimal.RequireFromString("64.91"),
+		decimal.RequireFromString("64.05"),
+		decimal.RequireFromString("63.28"),
+		decimal.RequireFromString("62.78"),
+		decimal.RequireFromString("62.36"),
+		decimal.RequireFromString("63.19"),
+		decimal.RequireFromString("64.69"),
+	}
+}
+
+// candleTestData returns candles used to verify streams and series
+// calculations. Closing prices match streamTestData, each candle opens
+// at the previous close.
+func candleTestData() []Candle {
+	dd := streamTestData()
+	cc := make([]Candle, len(dd))
+	start := time.Date(2023, 1, 2, 9, 30, 0, 0, time.UTC)
+
+	for i := range dd {
+		open := dd[i]
+		if i > 0 {
+			open = dd[i-1]
+		}
+
+		cc[i] = Candle{
+			Time:     start.Add(time.Duration(i) * time.Minute),
+			Interval: time.Minute,
+			Open:     open,
+			High:     decimal.Max(open, dd[i]).Add(decimal.RequireFromString("0.25")),
+			Low:      decimal.Min(open, dd[i]).Sub(decimal.RequireFromString("0.35")),
+			Close:    dd[i],
+			Volume:   decimal.NewFromInt(int64(1000 + i%5*100)),
+		}
+	}
+
+	return cc
+}
+
+func Test_NewStream(t *testing.T) {
+	calc := func(dd []decimal.Decimal) (decimal.Decimal, error) {
+		return dd[0], nil
+	}
+
+	cc := map[string]struct {
+		Count int
+		Calc  func([]decimal.Decimal) (decimal.Decimal, error)
+		Error error
+	}{
+		"Invalid count": {
+			Calc:  calc,
+			Error: ErrInvalidLength,
+		},
+		"Invalid calc function": {
+			Count: 1,
+			Error: ErrInvalidIndicator,
+		},
+		"Successfully created new Stream": {
+			Count: 3,
+			Calc:  calc,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewStream(c.Count, c.Calc)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				assert.Nil(t, res)
+				return
+			}
+
+			assert.True(t, res.valid)
+			assert.Equal(t, c.Count, res.count)
+			assert.Empty(t, res.window)
+		})
+	}
+}
+
+func Test_Stream_Update(t *testing.T) {
+	cc := map[string]struct {
+		Stream *Stream[decimal.Decimal, decimal.Decimal]
+		Data   []decimal.Decimal
+		Ready  []bool
+		Result []decimal.Decimal
+		Error  error
+	}{
+		"Invalid stream": {
+			Stream: &Stream[decimal.Decimal, decimal.Decimal]{},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+			},
+			Error: ErrInvalidIndicator,
+		},
+		"Calc returns an error": {
+			Stream: &Stream[decimal.Decimal, decimal.Decimal]{
+				valid: true,
+				count: 1,
+				calc: func(_ []decimal.Decimal) (decimal.Decimal, error) {
+					return decimal.Zero, assert.AnError
+				},
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+			},
+			Error: assert.AnError,
+		},
+		"Successful calculation": {
+			Stream: &Stream[decimal.Decimal, decimal.Decimal]{
+				valid: true,
+				count: 3,
+				calc:  SMA{valid: true, length: 3}.Calc,
+			},
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(4),
+				decimal.NewFromInt(8),
+			},
+			Ready: []bool{false, false, true, true, true},
+			Result: []decimal.Decimal{
+				decimal.Zero,
+				decimal.Zero,
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			for i := range c.Data {
+				res, ok, err := c.Stream.Update(c.Data[i])
+				assertEqualError(t, c.Error, err)
+
+				if err != nil {
+					assert.False(t, ok)
+					return
+				}
+
+				assert.Equal(t, c.Ready[i], ok)
+				assert.Equal(t, c.Result[i].String(), res.String())
+			}
+		})
+	}
+}
+
+func Test_Stream_Ready(t *testing.T) {
+	s, err := NewStream(2, SMA{valid: true, length: 2}.Calc)
+	assert.NoError(t, err)
+	assert.False(t, s.Ready())
+
+	_, _, err = s.Update(decimal.NewFromInt(1))
+	assert.NoError(t, err)
+	assert.False(t, s.Ready())
+
+	_, _, err = s.Update(decimal.NewFromInt(1))
+	assert.NoError(t, err)
+	assert.True(t, s.Ready())
+
+	assert.False(t, (&Stream[decimal.Decimal, decimal.Decimal]{}).Ready())
+}
+
+func Test_Stream_Reset(t *testing.T) {
+	s, err := NewStream(1, SMA{valid: true, length: 1}.Calc)
+	assert.NoError(t, err)
+
+	_, _, err = s.Update(decimal.NewFromInt(1))
+	assert.NoError(t, err)
+	assert.True(t, s.Ready())
+
+	s.Reset()
+	assert.False(t, s.Ready())
+	assert.Empty(t, s.window)
+}
+
+// assertSeriesMatchesCalc checks whether the series values are the same
+// as the ones calc produces over every window of the provided data points.
+func assertSeriesMatchesCalc[I, O any](
+	t *testing.T,
+	res []O,
+	count int,
+	calc func([]I) (O, error),
+	dd []I,
+) {
+
+	t.Helper()
+
+	assert.Len(t, res, len(dd)-count+1)
+
+	for i := range res {
+		exp, err := calc(dd[i : i+count])
+		assert.NoError(t, err)
+		assert.Equal(t, exp, res[i])
+	}
+}
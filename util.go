@@ -2,7 +2,7 @@ package tango
 
 import (
 	"errors"
-	"math"
+	"sync"
 
 	"github.com/shopspring/decimal"
 )
@@ -33,17 +33,58 @@ var (
 	// available trends.
 	ErrInvalidTrend = errors.New("invalid trend")
 
+	// ErrInvalidSentiment is returned when sentiment doesn't match any of
+	// the available sentiments.
+	ErrInvalidSentiment = errors.New("invalid sentiment")
+
+	// ErrInvalidReliability is returned when reliability doesn't match
+	// any of the available reliability levels.
+	ErrInvalidReliability = errors.New("invalid reliability")
+
 	// ErrInvalidBand is returned when band doesn't match any of the
 	// available bands.
 	ErrInvalidBand = errors.New("invalid band")
 
+	// ErrInvalidMACDOutput is returned when MACD output doesn't match any
+	// of the available MACD outputs.
+	ErrInvalidMACDOutput = errors.New("invalid macd output")
+
+	// ErrInvalidRSISmoothing is returned when RSI smoothing doesn't match
+	// any of the available RSI smoothing types.
+	ErrInvalidRSISmoothing = errors.New("invalid rsi smoothing")
+
 	// ErrInvalidMA is returned when ma doesn't match any of the
 	// availabble ma types.
 	ErrInvalidMA = errors.New("invalid moving average")
 
+	// ErrInvalidSession is returned when session is invalid.
+	ErrInvalidSession = errors.New("invalid session")
+
+	// ErrDuplicateMA is returned when moving average type with the same
+	// name is already registered.
+	ErrDuplicateMA = errors.New("duplicate moving average")
+
+	// ErrInvalidFactor is returned when factor is invalid.
+	ErrInvalidFactor = errors.New("invalid factor")
+
+	// ErrInvalidDeviation is returned when deviation doesn't match any of
+	// the available deviation measures or can't be used with the provided
+	// data.
+	ErrInvalidDeviation = errors.New("invalid deviation")
+
+	// ErrInvalidEMASeed is returned when EMA seed doesn't match any of
+	// the available EMA seeds.
+	ErrInvalidEMASeed = errors.New("invalid ema seed")
+
 	// ErrInvalidStandardDeviation is returned when standard deviation
 	// is invalid.
 	ErrInvalidStandardDeviation = errors.New("invalid standard deviation")
+
+	// ErrInvalidOffset is returned when offset is invalid.
+	ErrInvalidOffset = errors.New("invalid offset")
+
+	// ErrInvalidSigma is returned when sigma is invalid.
+	ErrInvalidSigma = errors.New("invalid sigma")
 )
 
 // Average is a helper function that calculates average decimal number of
@@ -58,10 +99,85 @@ func Average(dd []decimal.Decimal) decimal.Decimal {
 	return sum.Div(decimal.NewFromInt(int64(len(dd))))
 }
 
+// _squareRootPrecision specifies the number of significant digits square
+// roots are calculated to by SquareRoot, which is used by all standard
+// deviation calculations.
+const _squareRootPrecision int32 = 16
+
 // SquareRoot is a helper function that calculated the square root of decimal number.
+// The result is rounded to 16 significant digits, SquareRootDigits should be
+// used when a different precision is needed.
 func SquareRoot(d decimal.Decimal) decimal.Decimal {
-	f, _ := d.Float64()
-	return decimal.NewFromFloat(math.Sqrt(f))
+	return SquareRootDigits(d, _squareRootPrecision)
+}
+
+// SquareRootDigits calculates the square root of decimal number by using
+// Newton's method and rounds it to the provided number of significant
+// digits. At least one significant digit is always kept. Zero is returned
+// for non-positive numbers.
+func SquareRootDigits(d decimal.Decimal, digits int32) decimal.Decimal {
+	digits = max(digits, 1)
+
+	if !d.IsPositive() {
+		return decimal.Zero
+	}
+
+	// the square root has about half as many integer digits (or leading
+	// zeros of the fraction) as the number itself.
+	magnitude := int32(d.NumDigits()) + d.Exponent()
+	scale := magnitude / 2
+
+	if magnitude < 0 && magnitude%2 != 0 {
+		scale--
+	}
+
+	// intermediate results are calculated with a few extra decimal
+	// places, so that the final rounding is not affected by them.
+	precision := digits - scale + 2
+	tolerance := decimal.New(1, -precision)
+	half := decimal.New(5, -1)
+
+	// initial guess is the power of ten with half as many integer digits.
+	res := decimal.New(1, scale)
+
+	for i := 0; i < 100; i++ {
+		next := res.Add(d.DivRound(res, precision)).Mul(half).Round(precision)
+		done := next.Sub(res).Abs().LessThanOrEqual(tolerance)
+		res = next
+
+		if done {
+			break
+		}
+	}
+
+	return res.Round(digits - int32(res.NumDigits()) - res.Exponent())
+}
+
+// _expPrecision specifies the number of decimal places the terms of the
+// exponential function series are calculated to.
+const _expPrecision int32 = 24
+
+// exp calculates e raised to the power of the provided exponent by summing
+// its Taylor series. Negative exponents are calculated as the reciprocal of
+// the positive ones, so that the terms of the series don't alternate.
+func exp(d decimal.Decimal) decimal.Decimal {
+	x := d.Abs()
+	res, term := _one, _one
+
+	for i := int64(1); ; i++ {
+		term = term.Mul(x).DivRound(decimal.NewFromInt(i), _expPrecision)
+		if term.IsZero() {
+			break
+		}
+
+		res = res.Add(term)
+	}
+
+	if d.IsNegative() {
+		return _one.DivRound(res, _expPrecision)
+	}
+
+	return res
 }
 
 // MeanDeviation calculates mean deviation of given slice.
@@ -94,10 +210,253 @@ func StandardDeviation(dd []decimal.Decimal) decimal.Decimal {
 	mean := Average(dd)
 
 	for i := range dd {
-		res = res.Add(dd[i].Sub(mean).Pow(decimal.NewFromInt(2)).Div(length))
+		res = res.Add(dd[i].Sub(mean).Pow(decimal.NewFromInt(2)))
 	}
 
-	return SquareRoot(res)
+	return SquareRoot(variance(res, length))
+}
+
+// SampleStandardDeviation calculates sample standard deviation of given
+// slice, i.e. the sum of squared deviations is divided by n-1 instead of
+// n (Bessel's correction).
+func SampleStandardDeviation(dd []decimal.Decimal) decimal.Decimal {
+	if len(dd) < 2 {
+		return decimal.Zero
+	}
+
+	length := decimal.NewFromInt(int64(len(dd) - 1))
+	res := decimal.Zero
+	mean := Average(dd)
+
+	for i := range dd {
+		res = res.Add(dd[i].Sub(mean).Pow(decimal.NewFromInt(2)))
+	}
+
+	return SquareRoot(variance(res, length))
+}
+
+// variance divides the sum of squared deviations by the provided divisor.
+// A few more significant digits than _squareRootPrecision are kept, so that
+// the variance of tiny values is not rounded before its square root is
+// calculated.
+func variance(sum, divisor decimal.Decimal) decimal.Decimal {
+	if sum.IsZero() {
+		return decimal.Zero
+	}
+
+	// magnitude is the highest possible number of integer digits (or
+	// negated leading zeros of the fraction) of the quotient.
+	magnitude := int32(sum.NumDigits()) + sum.Exponent() - int32(divisor.NumDigits()) - divisor.Exponent() + 1
+
+	return sum.DivRound(divisor, _squareRootPrecision+2-magnitude)
+}
+
+// calcWindows calculates a value for every window of count data points
+// by passing each window to the provided calc function.
+func calcWindows[I, O any](count int, dd []I, calc func([]I) (O, error)) ([]O, error) {
+	if count < 1 || len(dd) < count {
+		return nil, ErrInvalidDataSize
+	}
+
+	res := make([]O, len(dd)-count+1)
+
+	for i := range res {
+		var err error
+
+		res[i], err = calc(dd[i : i+count])
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	return res, nil
+}
+
+// rollingExtremes calculates the lowest and the highest values of every
+// window of the given length. Monotonic deques are used so that the whole
+// calculation takes linear time.
+func rollingExtremes(dd []decimal.Decimal, length int) (lows, highs []decimal.Decimal) {
+	lidx, hidx := rollingExtremeIndexes(dd, length)
+	if lidx == nil {
+		return nil, nil
+	}
+
+	lows = make([]decimal.Decimal, len(lidx))
+	highs = make([]decimal.Decimal, len(hidx))
+
+	for i := range lidx {
+		lows[i] = dd[lidx[i]]
+		highs[i] = dd[hidx[i]]
+	}
+
+	return lows, highs
+}
+
+// rollingExtremeIndexes calculates the indexes of the lowest and the
+// highest values of every window of the given length. When the extreme
+// value occurs more than once, the index of the most recent occurrence
+// is used. Monotonic deques are used so that the whole calculation takes
+// linear time.
+func rollingExtremeIndexes(dd []decimal.Decimal, length int) (lows, highs []int) {
+	if length < 1 || len(dd) < length {
+		return nil, nil
+	}
+
+	lows = make([]int, len(dd)-length+1)
+	highs = make([]int, len(dd)-length+1)
+
+	// minq and maxq hold indexes of the values that may still become
+	// the lowest or the highest value of a window.
+	minq := make([]int, 0, length)
+	maxq := make([]int, 0, length)
+
+	for i := range dd {
+		for len(minq) > 0 && dd[minq[len(minq)-1]].GreaterThanOrEqual(dd[i]) {
+			minq = minq[:len(minq)-1]
+		}
+
+		for len(maxq) > 0 && dd[maxq[len(maxq)-1]].LessThanOrEqual(dd[i]) {
+			maxq = maxq[:len(maxq)-1]
+		}
+
+		minq = append(minq, i)
+		maxq = append(maxq, i)
+
+		if minq[0] <= i-length {
+			minq = minq[1:]
+		}
+
+		if maxq[0] <= i-length {
+			maxq = maxq[1:]
+		}
+
+		if i >= length-1 {
+			lows[i-length+1] = minq[0]
+			highs[i-length+1] = maxq[0]
+		}
+	}
+
+	return lows, highs
+}
+
+// rollingReversalIndexes calculates, for every window of the given length,
+// the indexes of the lowest and the highest values found by walking the
+// window backwards from its most recent value and stopping at the first
+// reversal, as Aroon does. Equal values do not stop the walk and the most
+// recent one of them is used. The whole calculation takes linear time.
+func rollingReversalIndexes(dd []decimal.Decimal, length int) (lows, highs []int) {
+	if length < 1 || len(dd) < length {
+		return nil, nil
+	}
+
+	// lowStarts and highStarts hold the start indexes of the
+	// non-decreasing and the non-increasing runs ending at each index,
+	// while flatEnds holds the end indexes of the runs of equal values
+	// starting at each index.
+	lowStarts := make([]int, len(dd))
+	highStarts := make([]int, len(dd))
+	flatEnds := make([]int, len(dd))
+
+	for i := 1; i < len(dd); i++ {
+		lowStarts[i], highStarts[i] = i, i
+
+		if dd[i-1].LessThanOrEqual(dd[i]) {
+			lowStarts[i] = lowStarts[i-1]
+		}
+
+		if dd[i-1].GreaterThanOrEqual(dd[i]) {
+			highStarts[i] = highStarts[i-1]
+		}
+	}
+
+	flatEnds[len(dd)-1] = len(dd) - 1
+
+	for i := len(dd) - 2; i >= 0; i-- {
+		flatEnds[i] = i
+
+		if dd[i].Equal(dd[i+1]) {
+			flatEnds[i] = flatEnds[i+1]
+		}
+	}
+
+	lows = make([]int, len(dd)-length+1)
+	highs = make([]int, len(dd)-length+1)
+
+	for i := range lows {
+		end := i + length - 1
+		lows[i] = min(flatEnds[max(lowStarts[end], i)], end)
+		highs[i] = min(flatEnds[max(highStarts[end], i)], end)
+	}
+
+	return lows, highs
+}
+
+// rollingSums calculates the sum of every window of the given length.
+// Decimal addition and subtraction are exact, so the sums are identical
+// to the ones calculated separately for every window.
+func rollingSums(dd []decimal.Decimal, length int) []decimal.Decimal {
+	if length < 1 || len(dd) < length {
+		return nil
+	}
+
+	res := make([]decimal.Decimal, len(dd)-length+1)
+
+	var sum decimal.Decimal
+
+	for i := range dd {
+		sum = sum.Add(dd[i])
+
+		if i >= length {
+			sum = sum.Sub(dd[i-length])
+		}
+
+		if i >= length-1 {
+			res[i-length+1] = sum
+		}
+	}
+
+	return res
+}
+
+// rollingStandardDeviations calculates population or sample standard
+// deviation of every window of the given length. Rolling sums are used so
+// that the whole calculation takes linear time.
+func rollingStandardDeviations(dd []decimal.Decimal, length int, sample bool) []decimal.Decimal {
+	if length < 1 || len(dd) < length {
+		return nil
+	}
+
+	res := make([]decimal.Decimal, len(dd)-length+1)
+	n := decimal.NewFromInt(int64(length))
+	dnm := n.Mul(n)
+
+	if sample {
+		if length < 2 {
+			return res
+		}
+
+		dnm = n.Mul(n.Sub(_one))
+	}
+
+	var sum, sqsum decimal.Decimal
+
+	for i := range dd {
+		sum = sum.Add(dd[i])
+		sqsum = sqsum.Add(dd[i].Mul(dd[i]))
+
+		if i >= length {
+			sum = sum.Sub(dd[i-length])
+			sqsum = sqsum.Sub(dd[i-length].Mul(dd[i-length]))
+		}
+
+		if i >= length-1 {
+			// variance is (n*sum(x^2) - sum(x)^2) / n^2 (or n*(n-1) for
+			// the sample), the numerator is exact, so it is never negative.
+			res[i-length+1] = SquareRoot(variance(sqsum.Mul(n).Sub(sum.Mul(sum)), dnm))
+		}
+	}
+
+	return res
 }
 
 // Trend specifies which trend should be used.
@@ -153,20 +512,140 @@ func (t *Trend) UnmarshalText(d []byte) error {
 	return nil
 }
 
+// Sentiment specifies the market sentiment indicated by a candlestick
+// pattern.
+type Sentiment int
+
+const (
+	// SentimentNeutral specifies indecisive market sentiment.
+	SentimentNeutral Sentiment = iota + 1
+
+	// SentimentBullish specifies expected price increase.
+	SentimentBullish
+
+	// SentimentBearish specifies expected price decrease.
+	SentimentBearish
+)
+
+// Validate checks whether the sentiment is one of
+// supported sentiment types or not.
+func (s Sentiment) Validate() error {
+	switch s {
+	case SentimentNeutral, SentimentBullish, SentimentBearish:
+		return nil
+	default:
+		return ErrInvalidSentiment
+	}
+}
+
+// MarshalText turns sentiment into appropriate string
+// representation.
+func (s Sentiment) MarshalText() ([]byte, error) {
+	var v string
+
+	switch s {
+	case SentimentNeutral:
+		v = "neutral"
+	case SentimentBullish:
+		v = "bullish"
+	case SentimentBearish:
+		v = "bearish"
+	default:
+		return nil, ErrInvalidSentiment
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns string to appropriate sentiment value.
+func (s *Sentiment) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "neutral":
+		*s = SentimentNeutral
+	case "bullish":
+		*s = SentimentBullish
+	case "bearish":
+		*s = SentimentBearish
+	default:
+		return ErrInvalidSentiment
+	}
+
+	return nil
+}
+
+// Reliability specifies how reliable the signal of a candlestick pattern
+// is.
+type Reliability int
+
+// Available reliability levels.
+const (
+	ReliabilityLow Reliability = iota + 1
+	ReliabilityMedium
+	ReliabilityHigh
+)
+
+// Validate checks whether the reliability is one of
+// supported reliability levels or not.
+func (r Reliability) Validate() error {
+	switch r {
+	case ReliabilityLow, ReliabilityMedium, ReliabilityHigh:
+		return nil
+	default:
+		return ErrInvalidReliability
+	}
+}
+
+// MarshalText turns reliability into appropriate string
+// representation.
+func (r Reliability) MarshalText() ([]byte, error) {
+	var v string
+
+	switch r {
+	case ReliabilityLow:
+		v = "low"
+	case ReliabilityMedium:
+		v = "medium"
+	case ReliabilityHigh:
+		v = "high"
+	default:
+		return nil, ErrInvalidReliability
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns string to appropriate reliability value.
+func (r *Reliability) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "low":
+		*r = ReliabilityLow
+	case "medium":
+		*r = ReliabilityMedium
+	case "high":
+		*r = ReliabilityHigh
+	default:
+		return ErrInvalidReliability
+	}
+
+	return nil
+}
+
 // Band specifies which band should be used.
 type Band int
 
-// Available Bollinger Band indicator types.
+// Available band types.
 const (
 	BandUpper Band = iota + 1
 	BandLower
 	BandWidth
+	BandMiddle
+	BandPercentB
 )
 
 // Validate checks whether band is one of supported band types.
 func (b Band) Validate() error {
 	switch b {
-	case BandUpper, BandLower, BandWidth:
+	case BandUpper, BandLower, BandWidth, BandMiddle, BandPercentB:
 		return nil
 	default:
 		return ErrInvalidBand
@@ -184,6 +663,10 @@ func (b Band) MarshalText() ([]byte, error) {
 		v = "lower"
 	case BandWidth:
 		v = "width"
+	case BandMiddle:
+		v = "middle"
+	case BandPercentB:
+		v = "percent-b"
 	default:
 		return nil, ErrInvalidBand
 	}
@@ -200,6 +683,10 @@ func (b *Band) UnmarshalText(d []byte) error {
 		*b = BandLower
 	case "width":
 		*b = BandWidth
+	case "middle":
+		*b = BandMiddle
+	case "percent-b":
+		*b = BandPercentB
 	default:
 		return ErrInvalidBand
 	}
@@ -207,77 +694,409 @@ func (b *Band) UnmarshalText(d []byte) error {
 	return nil
 }
 
+// Deviation specifies which deviation measure should be used.
+type Deviation int
+
+// Available deviation measures.
+const (
+	DeviationPopulation Deviation = iota + 1
+	DeviationSample
+	DeviationMean
+	DeviationATR
+)
+
+// Validate checks whether deviation is one of supported deviation
+// measures.
+func (dev Deviation) Validate() error {
+	switch dev {
+	case DeviationPopulation, DeviationSample, DeviationMean, DeviationATR:
+		return nil
+	default:
+		return ErrInvalidDeviation
+	}
+}
+
+// MarshalText turns deviation into appropriate string representation
+// in JSON.
+func (dev Deviation) MarshalText() ([]byte, error) {
+	var v string
+
+	switch dev {
+	case DeviationPopulation:
+		v = "population"
+	case DeviationSample:
+		v = "sample"
+	case DeviationMean:
+		v = "mean"
+	case DeviationATR:
+		v = "atr"
+	default:
+		return nil, ErrInvalidDeviation
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns JSON string to appropriate deviation value.
+func (dev *Deviation) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "population":
+		*dev = DeviationPopulation
+	case "sample":
+		*dev = DeviationSample
+	case "mean":
+		*dev = DeviationMean
+	case "atr":
+		*dev = DeviationATR
+	default:
+		return ErrInvalidDeviation
+	}
+
+	return nil
+}
+
+// EMASeed specifies how the first EMA value should be determined.
+type EMASeed int
+
+// Available EMA seeds.
+const (
+	// EMASeedSimple specifies that SMA of the first length data points
+	// should be used.
+	EMASeedSimple EMASeed = iota + 1
+
+	// EMASeedFirst specifies that the first data point should be used.
+	EMASeedFirst
+
+	// EMASeedValue specifies that the provided EMA value preceding the
+	// first data point should be used.
+	EMASeedValue
+)
+
+// Validate checks whether EMA seed is one of supported EMA seeds.
+func (es EMASeed) Validate() error {
+	switch es {
+	case EMASeedSimple, EMASeedFirst, EMASeedValue:
+		return nil
+	default:
+		return ErrInvalidEMASeed
+	}
+}
+
+// MarshalText turns EMA seed into appropriate string representation
+// in JSON.
+func (es EMASeed) MarshalText() ([]byte, error) {
+	var v string
+
+	switch es {
+	case EMASeedSimple:
+		v = "simple"
+	case EMASeedFirst:
+		v = "first"
+	case EMASeedValue:
+		v = "value"
+	default:
+		return nil, ErrInvalidEMASeed
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns JSON string to appropriate EMA seed value.
+func (es *EMASeed) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "simple":
+		*es = EMASeedSimple
+	case "first":
+		*es = EMASeedFirst
+	case "value":
+		*es = EMASeedValue
+	default:
+		return ErrInvalidEMASeed
+	}
+
+	return nil
+}
+
+// MACDOutput specifies which MACD value should be used.
+type MACDOutput int
+
+// Available MACD indicator outputs.
+const (
+	MACDOutputLine MACDOutput = iota + 1
+	MACDOutputSignal
+	MACDOutputHistogram
+)
+
+// Validate checks whether MACD output is one of supported output types.
+func (mo MACDOutput) Validate() error {
+	switch mo {
+	case MACDOutputLine, MACDOutputSignal, MACDOutputHistogram:
+		return nil
+	default:
+		return ErrInvalidMACDOutput
+	}
+}
+
+// MarshalText turns MACD output into appropriate string representation
+// in JSON.
+func (mo MACDOutput) MarshalText() ([]byte, error) {
+	var v string
+
+	switch mo {
+	case MACDOutputLine:
+		v = "line"
+	case MACDOutputSignal:
+		v = "signal"
+	case MACDOutputHistogram:
+		v = "histogram"
+	default:
+		return nil, ErrInvalidMACDOutput
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns JSON string to appropriate MACD output value.
+func (mo *MACDOutput) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "line":
+		*mo = MACDOutputLine
+	case "signal":
+		*mo = MACDOutputSignal
+	case "histogram":
+		*mo = MACDOutputHistogram
+	default:
+		return ErrInvalidMACDOutput
+	}
+
+	return nil
+}
+
+// RSISmoothing specifies how RSI average gains and losses should be
+// calculated.
+type RSISmoothing int
+
+// Available RSI smoothing types.
+const (
+	RSISmoothingSimple RSISmoothing = iota + 1
+	RSISmoothingWilder
+	RSISmoothingExponential
+)
+
+// Validate checks whether RSI smoothing is one of supported smoothing
+// types.
+func (rs RSISmoothing) Validate() error {
+	switch rs {
+	case RSISmoothingSimple, RSISmoothingWilder, RSISmoothingExponential:
+		return nil
+	default:
+		return ErrInvalidRSISmoothing
+	}
+}
+
+// MarshalText turns RSI smoothing into appropriate string representation
+// in JSON.
+func (rs RSISmoothing) MarshalText() ([]byte, error) {
+	var v string
+
+	switch rs {
+	case RSISmoothingSimple:
+		v = "simple"
+	case RSISmoothingWilder:
+		v = "wilder"
+	case RSISmoothingExponential:
+		v = "exponential"
+	default:
+		return nil, ErrInvalidRSISmoothing
+	}
+
+	return []byte(v), nil
+}
+
+// UnmarshalText turns JSON string to appropriate RSI smoothing value.
+func (rs *RSISmoothing) UnmarshalText(d []byte) error {
+	switch string(d) {
+	case "simple":
+		*rs = RSISmoothingSimple
+	case "wilder":
+		*rs = RSISmoothingWilder
+	case "exponential":
+		*rs = RSISmoothingExponential
+	default:
+		return ErrInvalidRSISmoothing
+	}
+
+	return nil
+}
+
 // MAType is a custom type that validates it to be only of existing
 // moving average types.
+//
+// Volume-weighted moving average (VWMA) is not one of them, because the
+// MA interface provides no volumes; VWMA calculated over candles should be
+// used instead.
 type MAType int
 
-// Available moving average indicator types.
+// Built-in moving average indicator types. Additional types can be
+// registered with RegisterMA.
 const (
 	MATypeDoubleExponential MAType = iota + 1
 	MATypeExponential
 	MATypeHull
 	MATypeSimple
 	MATypeWeighted
+	MATypeSmoothed
+	MATypeTripleExponential
+	MATypeKaufmanAdaptive
+	MATypeArnaudLegoux
+	MATypeZeroLagExponential
+	MATypeTillson
 )
 
+// MAConstructor creates new moving average of the provided length.
+type MAConstructor func(length int) (MA, error)
+
+// maEntry holds registered moving average type information.
+type maEntry struct {
+	// name specifies the text representation of the moving average type.
+	name string
+
+	// constructor specifies the function used to create the moving average.
+	constructor MAConstructor
+}
+
+// _maRegistry holds all registered moving average types. The entry of
+// each type is stored at the index of the type value minus one.
+var _maRegistry = struct {
+	mu      sync.RWMutex
+	entries []maEntry
+}{
+	entries: []maEntry{
+		{name: "double-exponential", constructor: func(length int) (MA, error) { return NewDEMA(length) }},
+		{name: "exponential", constructor: func(length int) (MA, error) { return NewEMA(length) }},
+		{name: "hull", constructor: func(length int) (MA, error) { return NewHMA(length) }},
+		{name: "simple", constructor: func(length int) (MA, error) { return NewSMA(length) }},
+		{name: "weighted", constructor: func(length int) (MA, error) { return NewWMA(length) }},
+		{name: "smoothed", constructor: func(length int) (MA, error) { return NewSMMA(length) }},
+		{name: "triple-exponential", constructor: func(length int) (MA, error) { return NewTEMA(length) }},
+		{name: "kaufman-adaptive", constructor: func(length int) (MA, error) { return NewKAMA(length) }},
+		{name: "arnaud-legoux", constructor: func(length int) (MA, error) { return NewALMA(length) }},
+		{name: "zero-lag-exponential", constructor: func(length int) (MA, error) { return NewZLEMA(length) }},
+		{name: "tillson", constructor: func(length int) (MA, error) { return NewT3(length) }},
+	},
+}
+
+// RegisterMA registers new moving average type, so that it can be used
+// by every indicator that accepts MAType. The name is used as the text
+// representation of the returned type and must not be already
+// registered. All of the built-in types are registered by default.
+//
+// The registry is process-wide state: a registered type is visible to
+// every package of the program and can't be removed. The registry is
+// guarded by a mutex, so it is safe to register types concurrently with
+// calculations, however it is recommended to do so once, during
+// initialization, as the values of the returned types depend on the
+// order of the registrations.
+func RegisterMA(name string, constructor MAConstructor) (MAType, error) {
+	if name == "" || constructor == nil {
+		return 0, ErrInvalidMA
+	}
+
+	_maRegistry.mu.Lock()
+	defer _maRegistry.mu.Unlock()
+
+	for _, entry := range _maRegistry.entries {
+		if entry.name == name {
+			return 0, ErrDuplicateMA
+		}
+	}
+
+	_maRegistry.entries = append(_maRegistry.entries, maEntry{
+		name:        name,
+		constructor: constructor,
+	})
+
+	return MAType(len(_maRegistry.entries)), nil
+}
+
+// unregisterMA removes the registered moving average type, so that the
+// registry can be restored after tests. The value of the type is reused
+// only when no types were registered after it.
+func unregisterMA(mat MAType) {
+	_maRegistry.mu.Lock()
+	defer _maRegistry.mu.Unlock()
+
+	if mat <= MATypeTillson || int(mat) > len(_maRegistry.entries) {
+		return
+	}
+
+	_maRegistry.entries[mat-1] = maEntry{}
+
+	for n := len(_maRegistry.entries); _maRegistry.entries[n-1].constructor == nil; n-- {
+		_maRegistry.entries = _maRegistry.entries[:n-1]
+	}
+}
+
+// entry returns registered information of the moving average type.
+func (mat MAType) entry() (maEntry, error) {
+	_maRegistry.mu.RLock()
+	defer _maRegistry.mu.RUnlock()
+
+	if mat < 1 || int(mat) > len(_maRegistry.entries) || _maRegistry.entries[mat-1].constructor == nil {
+		return maEntry{}, ErrInvalidMA
+	}
+
+	return _maRegistry.entries[mat-1], nil
+}
+
 // NewMA constructs new moving average based on the provided type.
 func NewMA(mat MAType, length int) (MA, error) {
-	switch mat {
-	case MATypeDoubleExponential:
-		return NewDEMA(length)
-	case MATypeExponential:
-		return NewEMA(length)
-	case MATypeHull:
-		return NewHMA(length)
-	case MATypeSimple:
-		return NewSMA(length)
-	case MATypeWeighted:
-		return NewWMA(length)
-	default:
-		return nil, ErrInvalidMA
+	entry, err := mat.entry()
+	if err != nil {
+		return nil, err
 	}
+
+	return entry.constructor(length)
 }
 
 // MarshalText turns MAType into appropriate string representation in JSON.
 func (mat MAType) MarshalText() ([]byte, error) {
-	var v string
-
-	switch mat {
-	case MATypeDoubleExponential:
-		v = "double-exponential"
-	case MATypeExponential:
-		v = "exponential"
-	case MATypeHull:
-		v = "hull"
-	case MATypeSimple:
-		v = "simple"
-	case MATypeWeighted:
-		v = "weighted"
-	default:
-		return nil, ErrInvalidMA
+	entry, err := mat.entry()
+	if err != nil {
+		return nil, err
 	}
 
-	return []byte(v), nil
+	return []byte(entry.name), nil
 }
 
 // UnmarshalText turns JSON string to appropriate moving average type value.
 func (mat *MAType) UnmarshalText(d []byte) error {
-	switch string(d) {
-	case "double-exponential":
-		*mat = MATypeDoubleExponential
-	case "exponential":
-		*mat = MATypeExponential
-	case "hull":
-		*mat = MATypeHull
-	case "simple":
-		*mat = MATypeSimple
-	case "weighted":
-		*mat = MATypeWeighted
-	default:
-		return ErrInvalidMA
+	_maRegistry.mu.RLock()
+	defer _maRegistry.mu.RUnlock()
+
+	for i, entry := range _maRegistry.entries {
+		if entry.constructor != nil && entry.name == string(d) {
+			*mat = MAType(i + 1)
+			return nil
+		}
 	}
 
-	return nil
+	return ErrInvalidMA
+}
+
+// CalcMASeries calculates moving average value for every window of
+// ma.Count() data points of the provided slice. Moving averages that
+// implement CalcSeries method use it, other ones are calculated window by
+// window. Either way, the values are identical to the ones ma.Calc
+// produces.
+func CalcMASeries(ma MA, dd []decimal.Decimal) ([]decimal.Decimal, error) {
+	if s, ok := ma.(interface {
+		CalcSeries([]decimal.Decimal) ([]decimal.Decimal, error)
+	}); ok {
+		return s.CalcSeries(dd)
+	}
+
+	return calcWindows(ma.Count(), dd, ma.Calc)
 }
 
 // MA is an interface that all moving averages implement.
@@ -1,6 +1,8 @@
 package tango
 
 import (
+	"fmt"
+	"sync"
 	"testing"
 
 	"github.com/shopspring/decimal"
@@ -23,6 +25,107 @@ func assertEqualError(t *testing.T, exp, err error) {
 
 	assert.NoError(t, err)
 }
+
+func Test_SquareRoot(t *testing.T) {
+	assert.Equal(t, "1.4142135623730950", SquareRoot(decimal.NewFromInt(2)).StringFixed(16))
+	assert.Equal(t, "5", SquareRoot(decimal.NewFromInt(25)).String())
+}
+
+func Test_SquareRootDigits(t *testing.T) {
+	cc := map[string]struct {
+		Value  decimal.Decimal
+		Digits int32
+		Result string
+	}{
+		"Negative value": {
+			Value:  decimal.NewFromInt(-4),
+			Digits: 16,
+			Result: "0",
+		},
+		"Zero value": {
+			Digits: 16,
+			Result: "0",
+		},
+		"Perfect square": {
+			Value:  decimal.NewFromInt(144),
+			Digits: 16,
+			Result: "12",
+		},
+		"Irrational square root": {
+			Value:  decimal.NewFromInt(2),
+			Digits: 40,
+			Result: "1.41421356237309504880168872420969807857",
+		},
+		"Large value": {
+			Value:  decimal.RequireFromString("123456789012345678901234567890.5"),
+			Digits: 20,
+			Result: "351364182882014.42531",
+		},
+		"Tiny value": {
+			Value:  decimal.RequireFromString("0.0000000000000002"),
+			Digits: 10,
+			Result: "0.00000001414213562",
+		},
+		"Zero digits": {
+			Value:  decimal.NewFromInt(2),
+			Result: "1",
+		},
+		"Negative digits": {
+			Value:  decimal.NewFromInt(150),
+			Digits: -3,
+			Result: "10",
+		},
+		"Rounded to the next power of ten": {
+			Value:  decimal.RequireFromString("99.99999"),
+			Digits: 3,
+			Result: "10",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.Result, SquareRootDigits(c.Value, c.Digits).String())
+		})
+	}
+}
+
+func Test_exp(t *testing.T) {
+	cc := map[string]struct {
+		Value  decimal.Decimal
+		Result string
+	}{
+		"Zero exponent": {
+			Result: "1.00000000000000000000",
+		},
+		"Positive exponent": {
+			Value:  decimal.NewFromInt(10),
+			Result: "22026.46579480671651695790",
+		},
+		"Negative exponent": {
+			Value:  decimal.NewFromInt(-1),
+			Result: "0.36787944117144232160",
+		},
+		"Large negative exponent": {
+			Value:  decimal.NewFromInt(-18),
+			Result: "0.00000001522997974471",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			assert.Equal(t, c.Result, exp(c.Value).StringFixed(20))
+		})
+	}
+}
+
 func Test_MeanDeviation(t *testing.T) {
 	cc := map[string]struct {
 		Data   []decimal.Decimal
@@ -32,19 +135,1139 @@ func Test_MeanDeviation(t *testing.T) {
 			Data:   []decimal.Decimal{},
 			Result: decimal.NewFromInt(0),
 		},
-		"Successful calculation with one value": {
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(2),
-			},
-			Result: decimal.NewFromInt(0),
+		"Successful calculation with one value": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+			},
+			Result: decimal.NewFromInt(0),
+		},
+		"Successful calculation": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(8),
+			},
+			Result: decimal.NewFromInt(2),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res := MeanDeviation(c.Data)
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_StandardDeviation(t *testing.T) {
+	cc := map[string]struct {
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+	}{
+		"Successful calculation with no values": {
+			Data:   []decimal.Decimal{},
+			Result: decimal.NewFromInt(0),
+		},
+		"Successful calculation with one value": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+			},
+			Result: decimal.NewFromInt(0),
+		},
+		"Successful calculation": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(600),
+				decimal.NewFromInt(470),
+				decimal.NewFromInt(170),
+				decimal.NewFromInt(430),
+				decimal.NewFromInt(300),
+			},
+			Result: SquareRoot(decimal.NewFromInt(21704)),
+		},
+		"Successful calculation with tiny values": {
+			Data: []decimal.Decimal{
+				decimal.RequireFromString("0.00000121"),
+				decimal.RequireFromString("0.00000123"),
+				decimal.RequireFromString("0.00000125"),
+			},
+			Result: decimal.RequireFromString("0.00000001632993161855452"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res := StandardDeviation(c.Data)
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_SampleStandardDeviation(t *testing.T) {
+	cc := map[string]struct {
+		Data   []decimal.Decimal
+		Result decimal.Decimal
+	}{
+		"Successful calculation with no values": {
+			Data:   []decimal.Decimal{},
+			Result: decimal.NewFromInt(0),
+		},
+		"Successful calculation with one value": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(2),
+			},
+			Result: decimal.NewFromInt(0),
+		},
+		"Successful calculation": {
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(600),
+				decimal.NewFromInt(470),
+				decimal.NewFromInt(170),
+				decimal.NewFromInt(430),
+				decimal.NewFromInt(300),
+			},
+			Result: SquareRoot(decimal.NewFromInt(27130)),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res := SampleStandardDeviation(c.Data)
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_calcWindows(t *testing.T) {
+	calc := func(dd []decimal.Decimal) (decimal.Decimal, error) {
+		return dd[0].Add(dd[len(dd)-1]), nil
+	}
+
+	cc := map[string]struct {
+		Count  int
+		Data   []decimal.Decimal
+		Calc   func([]decimal.Decimal) (decimal.Decimal, error)
+		Result []decimal.Decimal
+		Error  error
+	}{
+		"Invalid count": {
+			Data:  []decimal.Decimal{decimal.NewFromInt(1)},
+			Calc:  calc,
+			Error: ErrInvalidDataSize,
+		},
+		"Invalid data size": {
+			Count: 2,
+			Data:  []decimal.Decimal{decimal.NewFromInt(1)},
+			Calc:  calc,
+			Error: ErrInvalidDataSize,
+		},
+		"Calc returns an error": {
+			Count: 1,
+			Data:  []decimal.Decimal{decimal.NewFromInt(1)},
+			Calc: func(_ []decimal.Decimal) (decimal.Decimal, error) {
+				return decimal.Zero, assert.AnError
+			},
+			Error: assert.AnError,
+		},
+		"Successful calculation": {
+			Count: 2,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(3),
+			},
+			Calc: calc,
+			Result: []decimal.Decimal{
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(5),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := calcWindows(c.Count, c.Data, c.Calc)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_rollingExtremes(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Data   []decimal.Decimal
+		Lows   []decimal.Decimal
+		Highs  []decimal.Decimal
+	}{
+		"Invalid length": {
+			Data: []decimal.Decimal{decimal.NewFromInt(1)},
+		},
+		"Invalid data size": {
+			Length: 2,
+			Data:   []decimal.Decimal{decimal.NewFromInt(1)},
+		},
+		"Successful calculation": {
+			Length: 3,
+			Data: []decimal.Decimal{
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(7),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(6),
+			},
+			Lows: []decimal.Decimal{
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(1),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(2),
+				decimal.NewFromInt(2),
+			},
+			Highs: []decimal.Decimal{
+				decimal.NewFromInt(5),
+				decimal.NewFromInt(3),
+				decimal.NewFromInt(7),
+				decimal.NewFromInt(7),
+				decimal.NewFromInt(7),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			lows, highs := rollingExtremes(c.Data, c.Length)
+			assert.Equal(t, c.Lows, lows)
+			assert.Equal(t, c.Highs, highs)
+		})
+	}
+}
+
+func Test_rollingReversalIndexes(t *testing.T) {
+	lows, highs := rollingReversalIndexes(nil, 0)
+	assert.Nil(t, lows)
+	assert.Nil(t, highs)
+
+	var dd []decimal.Decimal
+	for _, v := range []int64{5, 1, 3, 3, 7, 7, 2} {
+		dd = append(dd, decimal.NewFromInt(v))
+	}
+
+	lows, highs = rollingReversalIndexes(dd, 3)
+	assert.Equal(t, []int{1, 1, 3, 3, 6}, lows)
+	assert.Equal(t, []int{2, 3, 4, 5, 5}, highs)
+}
+
+func Test_rollingSums(t *testing.T) {
+	assert.Nil(t, rollingSums(nil, 0))
+	assert.Nil(t, rollingSums([]decimal.Decimal{decimal.NewFromInt(1)}, 2))
+
+	res := rollingSums([]decimal.Decimal{
+		decimal.NewFromInt(5),
+		decimal.NewFromInt(1),
+		decimal.RequireFromString("3.5"),
+		decimal.NewFromInt(-2),
+		decimal.NewFromInt(7),
+	}, 3)
+
+	assert.Len(t, res, 3)
+	assert.Equal(t, "9.5", res[0].String())
+	assert.Equal(t, "2.5", res[1].String())
+	assert.Equal(t, "8.5", res[2].String())
+}
+
+func Test_rollingStandardDeviations(t *testing.T) {
+	assert.Nil(t, rollingStandardDeviations(nil, 0, false))
+	assert.Nil(t, rollingStandardDeviations([]decimal.Decimal{decimal.NewFromInt(1)}, 2, false))
+	assert.Equal(t, []decimal.Decimal{{}}, rollingStandardDeviations([]decimal.Decimal{decimal.NewFromInt(1)}, 1, true))
+
+	dd := []decimal.Decimal{
+		decimal.NewFromInt(600),
+		decimal.NewFromInt(470),
+		decimal.NewFromInt(170),
+		decimal.NewFromInt(430),
+		decimal.NewFromInt(300),
+		decimal.NewFromInt(300),
+	}
+
+	res := rollingStandardDeviations(dd, 5, false)
+	assert.Len(t, res, 2)
+
+	for i := range res {
+		assert.Equal(t, StandardDeviation(dd[i:i+5]).Round(8).String(), res[i].Round(8).String())
+	}
+
+	res = rollingStandardDeviations(dd, 5, true)
+	assert.Len(t, res, 2)
+
+	for i := range res {
+		assert.Equal(t, SampleStandardDeviation(dd[i:i+5]).Round(8).String(), res[i].Round(8).String())
+	}
+}
+
+func Test_MAType_TextRoundTrip(t *testing.T) {
+	for mat := MATypeDoubleExponential; mat <= MATypeTillson; mat++ {
+		text, err := mat.MarshalText()
+		assert.NoError(t, err)
+
+		var res MAType
+		assert.NoError(t, res.UnmarshalText(text))
+		assert.Equal(t, mat, res)
+	}
+}
+
+func Test_CalcMASeries(t *testing.T) {
+	dd := streamTestData()
+
+	res, err := CalcMASeries(SMA{valid: true, length: 3}, dd)
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, 3, SMA{valid: true, length: 3}.Calc, dd)
+
+	// maWithoutSeries hides CalcSeries method of the underlying SMA.
+	type maWithoutSeries struct {
+		MA
+	}
+
+	ma := maWithoutSeries{MA: SMA{valid: true, length: 3}}
+
+	res, err = CalcMASeries(ma, dd)
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, 3, ma.Calc, dd)
+
+	_, err = CalcMASeries(maWithoutSeries{MA: SMA{length: 3}}, dd)
+	assertEqualError(t, ErrInvalidIndicator, err)
+}
+
+func Test_Trend_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Trend Trend
+		Err   error
+	}{
+		"Invalid Trend": {
+			Err: ErrInvalidTrend,
+		},
+		"Successful TrendUp validation": {
+			Trend: TrendUp,
+		},
+		"Successful TrendDown validation": {
+			Trend: TrendDown,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Trend.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Trend_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Trend Trend
+		Text  string
+		Err   error
+	}{
+		"Invalid Trend": {
+			Err: ErrInvalidTrend,
+		},
+		"Successful TrendUp marshal": {
+			Trend: TrendUp,
+			Text:  "up",
+		},
+		"Successful TrendDown marshal": {
+			Trend: TrendDown,
+			Text:  "down",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Trend.MarshalText()
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Trend_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Trend
+		Err    error
+	}{
+		"Invalid Trend": {
+			Err: ErrInvalidTrend,
+		},
+		"Successful TrendUp unmarshal (long form)": {
+			Text:   "up",
+			Result: TrendUp,
+		},
+		"Successful TrendUp unmarshal (short form)": {
+			Text:   "u",
+			Result: TrendUp,
+		},
+		"Successful TrendDown unmarshal  (long form)": {
+			Text:   "down",
+			Result: TrendDown,
+		},
+		"Successful TrendDown unmarshal  (short form)": {
+			Text:   "d",
+			Result: TrendDown,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var tr Trend
+			err := tr.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, tr)
+		})
+	}
+}
+
+func Test_Sentiment_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Sentiment Sentiment
+		Err       error
+	}{
+		"Invalid Sentiment": {
+			Err: ErrInvalidSentiment,
+		},
+		"Successful SentimentNeutral validation": {
+			Sentiment: SentimentNeutral,
+		},
+		"Successful SentimentBullish validation": {
+			Sentiment: SentimentBullish,
+		},
+		"Successful SentimentBearish validation": {
+			Sentiment: SentimentBearish,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Sentiment.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Sentiment_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Sentiment Sentiment
+		Text      string
+		Err       error
+	}{
+		"Invalid Sentiment": {
+			Err: ErrInvalidSentiment,
+		},
+		"Successful SentimentNeutral marshal": {
+			Sentiment: SentimentNeutral,
+			Text:      "neutral",
+		},
+		"Successful SentimentBullish marshal": {
+			Sentiment: SentimentBullish,
+			Text:      "bullish",
+		},
+		"Successful SentimentBearish marshal": {
+			Sentiment: SentimentBearish,
+			Text:      "bearish",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Sentiment.MarshalText()
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Sentiment_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Sentiment
+		Err    error
+	}{
+		"Invalid Sentiment": {
+			Err: ErrInvalidSentiment,
+		},
+		"Successful SentimentNeutral unmarshal": {
+			Text:   "neutral",
+			Result: SentimentNeutral,
+		},
+		"Successful SentimentBullish unmarshal": {
+			Text:   "bullish",
+			Result: SentimentBullish,
+		},
+		"Successful SentimentBearish unmarshal": {
+			Text:   "bearish",
+			Result: SentimentBearish,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var st Sentiment
+			err := st.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, st)
+		})
+	}
+}
+
+func Test_Reliability_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Reliability Reliability
+		Err         error
+	}{
+		"Invalid Reliability": {
+			Err: ErrInvalidReliability,
+		},
+		"Successful ReliabilityLow validation": {
+			Reliability: ReliabilityLow,
+		},
+		"Successful ReliabilityMedium validation": {
+			Reliability: ReliabilityMedium,
+		},
+		"Successful ReliabilityHigh validation": {
+			Reliability: ReliabilityHigh,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Reliability.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Reliability_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Reliability Reliability
+		Text        string
+		Err         error
+	}{
+		"Invalid Reliability": {
+			Err: ErrInvalidReliability,
+		},
+		"Successful ReliabilityLow marshal": {
+			Reliability: ReliabilityLow,
+			Text:        "low",
+		},
+		"Successful ReliabilityMedium marshal": {
+			Reliability: ReliabilityMedium,
+			Text:        "medium",
+		},
+		"Successful ReliabilityHigh marshal": {
+			Reliability: ReliabilityHigh,
+			Text:        "high",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Reliability.MarshalText()
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Reliability_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Reliability
+		Err    error
+	}{
+		"Invalid Reliability": {
+			Err: ErrInvalidReliability,
+		},
+		"Successful ReliabilityLow unmarshal": {
+			Text:   "low",
+			Result: ReliabilityLow,
+		},
+		"Successful ReliabilityMedium unmarshal": {
+			Text:   "medium",
+			Result: ReliabilityMedium,
+		},
+		"Successful ReliabilityHigh unmarshal": {
+			Text:   "high",
+			Result: ReliabilityHigh,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var r Reliability
+			err := r.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, r)
+		})
+	}
+}
+
+func Test_Band_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Band Band
+		Err  error
+	}{
+		"Invalid Band": {
+			Err: ErrInvalidBand,
+		},
+		"Successful BandUpper validation": {
+			Band: BandUpper,
+		},
+		"Successful BandLower validation": {
+			Band: BandLower,
+		},
+		"Successful BandWidth validation": {
+			Band: BandWidth,
+		},
+		"Successful BandMiddle validation": {
+			Band: BandMiddle,
+		},
+		"Successful BandPercentB validation": {
+			Band: BandPercentB,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Band.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Band_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Band Band
+		Text string
+		Err  error
+	}{
+		"Invalid Band": {
+			Err: ErrInvalidBand,
+		},
+		"Successful BandUpper marshal": {
+			Band: BandUpper,
+			Text: "upper",
+		},
+		"Successful BandLower marshal": {
+			Band: BandLower,
+			Text: "lower",
+		},
+		"Successful BandWidth marshal": {
+			Band: BandWidth,
+			Text: "width",
+		},
+		"Successful BandMiddle marshal": {
+			Band: BandMiddle,
+			Text: "middle",
+		},
+		"Successful BandPercentB marshal": {
+			Band: BandPercentB,
+			Text: "percent-b",
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.Band.MarshalText()
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_Band_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result Band
+		Err    error
+	}{
+		"Invalid Band": {
+			Err: ErrInvalidBand,
+		},
+		"Successful BandUpper unmarshal": {
+			Text:   "upper",
+			Result: BandUpper,
+		},
+		"Successful BandLower unmarshal": {
+			Text:   "lower",
+			Result: BandLower,
+		},
+		"Successful BandWidth unmarshal": {
+			Text:   "width",
+			Result: BandWidth,
+		},
+		"Successful BandMiddle unmarshal": {
+			Text:   "middle",
+			Result: BandMiddle,
+		},
+		"Successful BandPercentB unmarshal": {
+			Text:   "percent-b",
+			Result: BandPercentB,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var b Band
+			err := b.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, b)
+		})
+	}
+}
+
+func Test_RegisterMA(t *testing.T) {
+	constructor := func(length int) (MA, error) {
+		return NewSMA(length)
+	}
+
+	_, err := RegisterMA("", constructor)
+	assertEqualError(t, ErrInvalidMA, err)
+
+	_, err = RegisterMA("registered", nil)
+	assertEqualError(t, ErrInvalidMA, err)
+
+	_, err = RegisterMA("simple", constructor)
+	assertEqualError(t, ErrDuplicateMA, err)
+
+	mat, err := RegisterMA("registered", constructor)
+	assert.NoError(t, err)
+	assert.Greater(t, mat, MATypeTillson)
+
+	t.Cleanup(func() {
+		unregisterMA(mat)
+	})
+
+	_, err = RegisterMA("registered", constructor)
+	assertEqualError(t, ErrDuplicateMA, err)
+
+	ma, err := NewMA(mat, 3)
+	assert.NoError(t, err)
+	assert.Equal(t, SMA{valid: true, length: 3}, ma)
+
+	text, err := mat.MarshalText()
+	assert.NoError(t, err)
+	assert.Equal(t, "registered", string(text))
+
+	var res MAType
+	assert.NoError(t, res.UnmarshalText([]byte("registered")))
+	assert.Equal(t, mat, res)
+
+	_, err = NewBB(mat, decimal.NewFromInt(2), 3)
+	assert.NoError(t, err)
+}
+
+func Test_RegisterMA_Concurrently(t *testing.T) {
+	constructor := func(length int) (MA, error) {
+		return NewSMA(length)
+	}
+
+	var wg sync.WaitGroup
+
+	for i := 0; i < 10; i++ {
+		wg.Add(2)
+
+		go func(i int) {
+			defer wg.Done()
+
+			mat, err := RegisterMA(fmt.Sprintf("concurrent-%d", i), constructor)
+			assert.NoError(t, err)
+
+			t.Cleanup(func() {
+				unregisterMA(mat)
+			})
+		}(i)
+
+		go func() {
+			defer wg.Done()
+
+			_, err := NewMA(MATypeSimple, 3)
+			assert.NoError(t, err)
+
+			var mat MAType
+			assert.NoError(t, mat.UnmarshalText([]byte("simple")))
+		}()
+	}
+
+	wg.Wait()
+}
+
+func Test_unregisterMA(t *testing.T) {
+	constructor := func(length int) (MA, error) {
+		return NewSMA(length)
+	}
+
+	first, err := RegisterMA("unregistered-first", constructor)
+	assert.NoError(t, err)
+
+	second, err := RegisterMA("unregistered-second", constructor)
+	assert.NoError(t, err)
+
+	unregisterMA(MATypeSimple)
+
+	_, err = NewMA(MATypeSimple, 3)
+	assert.NoError(t, err)
+
+	unregisterMA(first)
+
+	_, err = NewMA(first, 3)
+	assertEqualError(t, ErrInvalidMA, err)
+
+	var mat MAType
+	assertEqualError(t, ErrInvalidMA, mat.UnmarshalText([]byte("unregistered-first")))
+
+	_, err = NewMA(second, 3)
+	assert.NoError(t, err)
+
+	unregisterMA(second)
+
+	_, err = NewMA(second, 3)
+	assertEqualError(t, ErrInvalidMA, err)
+
+	res, err := RegisterMA("unregistered-first", constructor)
+	assert.NoError(t, err)
+	assert.Equal(t, first, res)
+
+	unregisterMA(res)
+}
+
+func Test_NewMA(t *testing.T) {
+	cc := map[string]struct {
+		Type      MAType
+		Length    int
+		Indicator MA
+		Err       error
+	}{
+		"Invalid MAType": {
+			Err: ErrInvalidMA,
+		},
+		"Successful MATypeDoubleExponential initialization": {
+			Type:   MATypeDoubleExponential,
+			Length: 1,
+			Indicator: DEMA{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 1,
+					},
+				},
+			},
+		},
+		"Successful MATypeExponential initialization": {
+			Type:   MATypeExponential,
+			Length: 1,
+			Indicator: EMA{
+				valid: true,
+				sma: SMA{
+					valid:  true,
+					length: 1,
+				},
+			},
+		},
+		"Successful MATypeHull initialization": {
+			Type:   MATypeHull,
+			Length: 1,
+			Indicator: HMA{
+				valid: true,
+				wma: WMA{
+					valid:  true,
+					length: 1,
+				},
+			},
+		},
+		"Successful MATypeSimple initialization": {
+			Type:   MATypeSimple,
+			Length: 1,
+			Indicator: SMA{
+				valid:  true,
+				length: 1,
+			},
+		},
+		"Successful MATypeWeighted initialization": {
+			Type:   MATypeWeighted,
+			Length: 1,
+			Indicator: WMA{
+				valid:  true,
+				length: 1,
+			},
+		},
+		"Successful MATypeSmoothed initialization": {
+			Type:   MATypeSmoothed,
+			Length: 1,
+			Indicator: SMMA{
+				valid: true,
+				sma: SMA{
+					valid:  true,
+					length: 1,
+				},
+			},
+		},
+		"Successful MATypeTripleExponential initialization": {
+			Type:   MATypeTripleExponential,
+			Length: 1,
+			Indicator: TEMA{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 1,
+					},
+				},
+			},
+		},
+		"Successful MATypeKaufmanAdaptive initialization": {
+			Type:   MATypeKaufmanAdaptive,
+			Length: 1,
+			Indicator: KAMA{
+				valid: true,
+				sma: SMA{
+					valid:  true,
+					length: 1,
+				},
+				fast: 2,
+				slow: 30,
+			},
+		},
+		"Successful MATypeArnaudLegoux initialization": {
+			Type:   MATypeArnaudLegoux,
+			Length: 1,
+			Indicator: ALMA{
+				valid:  true,
+				length: 1,
+				offset: decimal.RequireFromString("0.85"),
+				sigma:  decimal.NewFromInt(6),
+			},
+		},
+		"Successful MATypeZeroLagExponential initialization": {
+			Type:   MATypeZeroLagExponential,
+			Length: 1,
+			Indicator: ZLEMA{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 1,
+					},
+				},
+			},
+		},
+		"Successful MATypeTillson initialization": {
+			Type:   MATypeTillson,
+			Length: 1,
+			Indicator: T3{
+				valid: true,
+				ema: EMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 1,
+					},
+				},
+				factor: decimal.RequireFromString("0.7"),
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			ma, err := NewMA(c.Type, c.Length)
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Indicator, ma)
+		})
+	}
+}
+
+func Test_Deviation_Validate(t *testing.T) {
+	cc := map[string]struct {
+		Deviation Deviation
+		Err       error
+	}{
+		"Invalid Deviation": {
+			Err: ErrInvalidDeviation,
+		},
+		"Successful DeviationPopulation validation": {
+			Deviation: DeviationPopulation,
+		},
+		"Successful DeviationSample validation": {
+			Deviation: DeviationSample,
+		},
+		"Successful DeviationMean validation": {
+			Deviation: DeviationMean,
+		},
+		"Successful DeviationATR validation": {
+			Deviation: DeviationATR,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.Deviation.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_Deviation_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Deviation Deviation
+		Text      string
+		Err       error
+	}{
+		"Invalid Deviation": {
+			Err: ErrInvalidDeviation,
+		},
+		"Successful DeviationPopulation marshal": {
+			Deviation: DeviationPopulation,
+			Text:      "population",
+		},
+		"Successful DeviationSample marshal": {
+			Deviation: DeviationSample,
+			Text:      "sample",
 		},
-		"Successful calculation": {
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(2),
-				decimal.NewFromInt(5),
-				decimal.NewFromInt(8),
-			},
-			Result: decimal.NewFromInt(2),
+		"Successful DeviationMean marshal": {
+			Deviation: DeviationMean,
+			Text:      "mean",
+		},
+		"Successful DeviationATR marshal": {
+			Deviation: DeviationATR,
+			Text:      "atr",
 		},
 	}
 
@@ -54,37 +1277,42 @@ func Test_MeanDeviation(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res := MeanDeviation(c.Data)
+			res, err := c.Deviation.MarshalText()
+			assertEqualError(t, c.Err, err)
 
-			assert.Equal(t, c.Result.String(), res.String())
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Text, string(res))
 		})
 	}
 }
 
-func Test_StandardDeviation(t *testing.T) {
+func Test_Deviation_UnmarshalText(t *testing.T) {
 	cc := map[string]struct {
-		Data   []decimal.Decimal
-		Result decimal.Decimal
+		Text   string
+		Result Deviation
+		Err    error
 	}{
-		"Successful calculation with no values": {
-			Data:   []decimal.Decimal{},
-			Result: decimal.NewFromInt(0),
+		"Invalid Deviation": {
+			Err: ErrInvalidDeviation,
 		},
-		"Successful calculation with one value": {
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(2),
-			},
-			Result: decimal.NewFromInt(0),
+		"Successful DeviationPopulation unmarshal": {
+			Text:   "population",
+			Result: DeviationPopulation,
 		},
-		"Successful calculation": {
-			Data: []decimal.Decimal{
-				decimal.NewFromInt(600),
-				decimal.NewFromInt(470),
-				decimal.NewFromInt(170),
-				decimal.NewFromInt(430),
-				decimal.NewFromInt(300),
-			},
-			Result: SquareRoot(decimal.NewFromInt(21704)),
+		"Successful DeviationSample unmarshal": {
+			Text:   "sample",
+			Result: DeviationSample,
+		},
+		"Successful DeviationMean unmarshal": {
+			Text:   "mean",
+			Result: DeviationMean,
+		},
+		"Successful DeviationATR unmarshal": {
+			Text:   "atr",
+			Result: DeviationATR,
 		},
 	}
 
@@ -94,26 +1322,35 @@ func Test_StandardDeviation(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res := StandardDeviation(c.Data)
+			var dev Deviation
+			err := dev.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
 
-			assert.Equal(t, c.Result.String(), res.String())
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, dev)
 		})
 	}
 }
 
-func Test_Trend_Validate(t *testing.T) {
+func Test_EMASeed_Validate(t *testing.T) {
 	cc := map[string]struct {
-		Trend Trend
-		Err   error
+		EMASeed EMASeed
+		Err     error
 	}{
-		"Invalid Trend": {
-			Err: ErrInvalidTrend,
+		"Invalid EMASeed": {
+			Err: ErrInvalidEMASeed,
 		},
-		"Successful TrendUp validation": {
-			Trend: TrendUp,
+		"Successful EMASeedSimple validation": {
+			EMASeed: EMASeedSimple,
 		},
-		"Successful TrendDown validation": {
-			Trend: TrendDown,
+		"Successful EMASeedFirst validation": {
+			EMASeed: EMASeedFirst,
+		},
+		"Successful EMASeedValue validation": {
+			EMASeed: EMASeedValue,
 		},
 	}
 
@@ -123,28 +1360,32 @@ func Test_Trend_Validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			err := c.Trend.Validate()
+			err := c.EMASeed.Validate()
 			assertEqualError(t, c.Err, err)
 		})
 	}
 }
 
-func Test_Trend_MarshalText(t *testing.T) {
+func Test_EMASeed_MarshalText(t *testing.T) {
 	cc := map[string]struct {
-		Trend Trend
-		Text  string
-		Err   error
+		EMASeed EMASeed
+		Text    string
+		Err     error
 	}{
-		"Invalid Trend": {
-			Err: ErrInvalidTrend,
+		"Invalid EMASeed": {
+			Err: ErrInvalidEMASeed,
 		},
-		"Successful TrendUp marshal": {
-			Trend: TrendUp,
-			Text:  "up",
+		"Successful EMASeedSimple marshal": {
+			EMASeed: EMASeedSimple,
+			Text:    "simple",
 		},
-		"Successful TrendDown marshal": {
-			Trend: TrendDown,
-			Text:  "down",
+		"Successful EMASeedFirst marshal": {
+			EMASeed: EMASeedFirst,
+			Text:    "first",
+		},
+		"Successful EMASeedValue marshal": {
+			EMASeed: EMASeedValue,
+			Text:    "value",
 		},
 	}
 
@@ -154,7 +1395,7 @@ func Test_Trend_MarshalText(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.Trend.MarshalText()
+			res, err := c.EMASeed.MarshalText()
 			assertEqualError(t, c.Err, err)
 
 			if err != nil {
@@ -166,30 +1407,26 @@ func Test_Trend_MarshalText(t *testing.T) {
 	}
 }
 
-func Test_Trend_UnmarshalText(t *testing.T) {
+func Test_EMASeed_UnmarshalText(t *testing.T) {
 	cc := map[string]struct {
 		Text   string
-		Result Trend
+		Result EMASeed
 		Err    error
 	}{
-		"Invalid Trend": {
-			Err: ErrInvalidTrend,
-		},
-		"Successful TrendUp unmarshal (long form)": {
-			Text:   "up",
-			Result: TrendUp,
+		"Invalid EMASeed": {
+			Err: ErrInvalidEMASeed,
 		},
-		"Successful TrendUp unmarshal (short form)": {
-			Text:   "u",
-			Result: TrendUp,
+		"Successful EMASeedSimple unmarshal": {
+			Text:   "simple",
+			Result: EMASeedSimple,
 		},
-		"Successful TrendDown unmarshal  (long form)": {
-			Text:   "down",
-			Result: TrendDown,
+		"Successful EMASeedFirst unmarshal": {
+			Text:   "first",
+			Result: EMASeedFirst,
 		},
-		"Successful TrendDown unmarshal  (short form)": {
-			Text:   "d",
-			Result: TrendDown,
+		"Successful EMASeedValue unmarshal": {
+			Text:   "value",
+			Result: EMASeedValue,
 		},
 	}
 
@@ -199,35 +1436,35 @@ func Test_Trend_UnmarshalText(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			var tr Trend
-			err := tr.UnmarshalText([]byte(c.Text))
+			var es EMASeed
+			err := es.UnmarshalText([]byte(c.Text))
 			assertEqualError(t, c.Err, err)
 
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result, tr)
+			assert.Equal(t, c.Result, es)
 		})
 	}
 }
 
-func Test_Band_Validate(t *testing.T) {
+func Test_MACDOutput_Validate(t *testing.T) {
 	cc := map[string]struct {
-		Band Band
-		Err  error
+		MACDOutput MACDOutput
+		Err        error
 	}{
-		"Invalid Band": {
-			Err: ErrInvalidBand,
+		"Invalid MACDOutput": {
+			Err: ErrInvalidMACDOutput,
 		},
-		"Successful BandUpper validation": {
-			Band: BandUpper,
+		"Successful MACDOutputLine validation": {
+			MACDOutput: MACDOutputLine,
 		},
-		"Successful BandLower validation": {
-			Band: BandLower,
+		"Successful MACDOutputSignal validation": {
+			MACDOutput: MACDOutputSignal,
 		},
-		"Successful BandWidth validation": {
-			Band: BandWidth,
+		"Successful MACDOutputHistogram validation": {
+			MACDOutput: MACDOutputHistogram,
 		},
 	}
 
@@ -237,32 +1474,32 @@ func Test_Band_Validate(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			err := c.Band.Validate()
+			err := c.MACDOutput.Validate()
 			assertEqualError(t, c.Err, err)
 		})
 	}
 }
 
-func Test_Band_MarshalText(t *testing.T) {
+func Test_MACDOutput_MarshalText(t *testing.T) {
 	cc := map[string]struct {
-		Band Band
-		Text string
-		Err  error
+		MACDOutput MACDOutput
+		Text       string
+		Err        error
 	}{
-		"Invalid Band": {
-			Err: ErrInvalidBand,
+		"Invalid MACDOutput": {
+			Err: ErrInvalidMACDOutput,
 		},
-		"Successful BandUpper marshal": {
-			Band: BandUpper,
-			Text: "upper",
+		"Successful MACDOutputLine marshal": {
+			MACDOutput: MACDOutputLine,
+			Text:       "line",
 		},
-		"Successful BandLower marshal": {
-			Band: BandLower,
-			Text: "lower",
+		"Successful MACDOutputSignal marshal": {
+			MACDOutput: MACDOutputSignal,
+			Text:       "signal",
 		},
-		"Successful BandWidth marshal": {
-			Band: BandWidth,
-			Text: "width",
+		"Successful MACDOutputHistogram marshal": {
+			MACDOutput: MACDOutputHistogram,
+			Text:       "histogram",
 		},
 	}
 
@@ -272,7 +1509,7 @@ func Test_Band_MarshalText(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			res, err := c.Band.MarshalText()
+			res, err := c.MACDOutput.MarshalText()
 			assertEqualError(t, c.Err, err)
 
 			if err != nil {
@@ -284,26 +1521,26 @@ func Test_Band_MarshalText(t *testing.T) {
 	}
 }
 
-func Test_Band_UnmarshalText(t *testing.T) {
+func Test_MACDOutput_UnmarshalText(t *testing.T) {
 	cc := map[string]struct {
 		Text   string
-		Result Band
+		Result MACDOutput
 		Err    error
 	}{
-		"Invalid Band": {
-			Err: ErrInvalidBand,
+		"Invalid MACDOutput": {
+			Err: ErrInvalidMACDOutput,
 		},
-		"Successful BandUpper unmarshal": {
-			Text:   "upper",
-			Result: BandUpper,
+		"Successful MACDOutputLine unmarshal": {
+			Text:   "line",
+			Result: MACDOutputLine,
 		},
-		"Successful BandLower unmarshal": {
-			Text:   "lower",
-			Result: BandLower,
+		"Successful MACDOutputSignal unmarshal": {
+			Text:   "signal",
+			Result: MACDOutputSignal,
 		},
-		"Successful BandWidth unmarshal": {
-			Text:   "width",
-			Result: BandWidth,
+		"Successful MACDOutputHistogram unmarshal": {
+			Text:   "histogram",
+			Result: MACDOutputHistogram,
 		},
 	}
 
@@ -313,80 +1550,70 @@ func Test_Band_UnmarshalText(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			var b Band
-			err := b.UnmarshalText([]byte(c.Text))
+			var mo MACDOutput
+			err := mo.UnmarshalText([]byte(c.Text))
 			assertEqualError(t, c.Err, err)
 
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Result, b)
+			assert.Equal(t, c.Result, mo)
 		})
 	}
 }
 
-func Test_NewMA(t *testing.T) {
+func Test_RSISmoothing_Validate(t *testing.T) {
 	cc := map[string]struct {
-		Type      MAType
-		Length    int
-		Indicator MA
-		Err       error
+		RSISmoothing RSISmoothing
+		Err          error
 	}{
-		"Invalid MAType": {
-			Err: ErrInvalidMA,
+		"Invalid RSISmoothing": {
+			Err: ErrInvalidRSISmoothing,
 		},
-		"Successful MATypeDoubleExponential initialization": {
-			Type:   MATypeDoubleExponential,
-			Length: 1,
-			Indicator: DEMA{
-				valid: true,
-				ema: EMA{
-					valid: true,
-					sma: SMA{
-						valid:  true,
-						length: 1,
-					},
-				},
-			},
+		"Successful RSISmoothingSimple validation": {
+			RSISmoothing: RSISmoothingSimple,
 		},
-		"Successful MATypeExponential initialization": {
-			Type:   MATypeExponential,
-			Length: 1,
-			Indicator: EMA{
-				valid: true,
-				sma: SMA{
-					valid:  true,
-					length: 1,
-				},
-			},
+		"Successful RSISmoothingWilder validation": {
+			RSISmoothing: RSISmoothingWilder,
 		},
-		"Successful MATypeHull initialization": {
-			Type:   MATypeHull,
-			Length: 1,
-			Indicator: HMA{
-				valid: true,
-				wma: WMA{
-					valid:  true,
-					length: 1,
-				},
-			},
+		"Successful RSISmoothingExponential validation": {
+			RSISmoothing: RSISmoothingExponential,
 		},
-		"Successful MATypeSimple initialization": {
-			Type:   MATypeSimple,
-			Length: 1,
-			Indicator: SMA{
-				valid:  true,
-				length: 1,
-			},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.RSISmoothing.Validate()
+			assertEqualError(t, c.Err, err)
+		})
+	}
+}
+
+func Test_RSISmoothing_MarshalText(t *testing.T) {
+	cc := map[string]struct {
+		RSISmoothing RSISmoothing
+		Text         string
+		Err          error
+	}{
+		"Invalid RSISmoothing": {
+			Err: ErrInvalidRSISmoothing,
 		},
-		"Successful MATypeWeighted initialization": {
-			Type:   MATypeWeighted,
-			Length: 1,
-			Indicator: WMA{
-				valid:  true,
-				length: 1,
-			},
+		"Successful RSISmoothingSimple marshal": {
+			RSISmoothing: RSISmoothingSimple,
+			Text:         "simple",
+		},
+		"Successful RSISmoothingWilder marshal": {
+			RSISmoothing: RSISmoothingWilder,
+			Text:         "wilder",
+		},
+		"Successful RSISmoothingExponential marshal": {
+			RSISmoothing: RSISmoothingExponential,
+			Text:         "exponential",
 		},
 	}
 
@@ -396,14 +1623,56 @@ func Test_NewMA(t *testing.T) {
 		t.Run(cn, func(t *testing.T) {
 			t.Parallel()
 
-			ma, err := NewMA(c.Type, c.Length)
+			res, err := c.RSISmoothing.MarshalText()
 			assertEqualError(t, c.Err, err)
 
 			if err != nil {
 				return
 			}
 
-			assert.Equal(t, c.Indicator, ma)
+			assert.Equal(t, c.Text, string(res))
+		})
+	}
+}
+
+func Test_RSISmoothing_UnmarshalText(t *testing.T) {
+	cc := map[string]struct {
+		Text   string
+		Result RSISmoothing
+		Err    error
+	}{
+		"Invalid RSISmoothing": {
+			Err: ErrInvalidRSISmoothing,
+		},
+		"Successful RSISmoothingSimple unmarshal": {
+			Text:   "simple",
+			Result: RSISmoothingSimple,
+		},
+		"Successful RSISmoothingWilder unmarshal": {
+			Text:   "wilder",
+			Result: RSISmoothingWilder,
+		},
+		"Successful RSISmoothingExponential unmarshal": {
+			Text:   "exponential",
+			Result: RSISmoothingExponential,
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			var rs RSISmoothing
+			err := rs.UnmarshalText([]byte(c.Text))
+			assertEqualError(t, c.Err, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result, rs)
 		})
 	}
 }
@@ -438,6 +1707,30 @@ func Test_MAType_MarshalText(t *testing.T) {
 			Type: MATypeWeighted,
 			Text: "weighted",
 		},
+		"Successful MATypeSmoothed marshal": {
+			Type: MATypeSmoothed,
+			Text: "smoothed",
+		},
+		"Successful MATypeTripleExponential marshal": {
+			Type: MATypeTripleExponential,
+			Text: "triple-exponential",
+		},
+		"Successful MATypeKaufmanAdaptive marshal": {
+			Type: MATypeKaufmanAdaptive,
+			Text: "kaufman-adaptive",
+		},
+		"Successful MATypeArnaudLegoux marshal": {
+			Type: MATypeArnaudLegoux,
+			Text: "arnaud-legoux",
+		},
+		"Successful MATypeZeroLagExponential marshal": {
+			Type: MATypeZeroLagExponential,
+			Text: "zero-lag-exponential",
+		},
+		"Successful MATypeTillson marshal": {
+			Type: MATypeTillson,
+			Text: "tillson",
+		},
 	}
 
 	for cn, c := range cc {
@@ -488,6 +1781,30 @@ func Test_MAType_UnmarshalText(t *testing.T) {
 			Text:   "weighted",
 			Result: MATypeWeighted,
 		},
+		"Successful MATypeSmoothed unmarshal": {
+			Text:   "smoothed",
+			Result: MATypeSmoothed,
+		},
+		"Successful MATypeTripleExponential unmarshal": {
+			Text:   "triple-exponential",
+			Result: MATypeTripleExponential,
+		},
+		"Successful MATypeKaufmanAdaptive unmarshal": {
+			Text:   "kaufman-adaptive",
+			Result: MATypeKaufmanAdaptive,
+		},
+		"Successful MATypeArnaudLegoux unmarshal": {
+			Text:   "arnaud-legoux",
+			Result: MATypeArnaudLegoux,
+		},
+		"Successful MATypeZeroLagExponential unmarshal": {
+			Text:   "zero-lag-exponential",
+			Result: MATypeZeroLagExponential,
+		},
+		"Successful MATypeTillson unmarshal": {
+			Text:   "tillson",
+			Result: MATypeTillson,
+		},
 	}
 
 	for cn, c := range cc {
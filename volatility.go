@@ -0,0 +1,100 @@
+package tango
+
+import "github.com/shopspring/decimal"
+
+// ATR holds all the necessary information needed to calculate average
+// true range.
+// The zero value is not usable.
+type ATR struct {
+	// valid specifies whether ATR paremeters were validated.
+	valid bool
+
+	// ma specifies MA indicator configuration used to smooth
+	// true range values.
+	ma MA
+}
+
+// NewATR validates provided configuration options and creates new ATR
+// indicator. Wilder's original smoothing is used with MATypeSmoothed,
+// however any other moving average type may be used as well.
+func NewATR(length int, mat MAType) (ATR, error) {
+	ma, err := NewMA(mat, length)
+	if err != nil {
+		return ATR{}, err
+	}
+
+	return ATR{
+		valid: true,
+		ma:    ma,
+	}, nil
+}
+
+// Calc calculates ATR from the provided candles slice. The first candle
+// is used only for its closing price, which is needed to calculate the
+// true range of the second candle.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/a/atr.asp.
+// All credits are due to J. Welles Wilder Jr. who developed ATR indicator.
+func (atr ATR) Calc(cc []Candle) (decimal.Decimal, error) {
+	if !atr.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != atr.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	res, err := atr.ma.Calc(trueRanges(cc))
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for ATR
+// calculation.
+func (atr ATR) Count() int {
+	return atr.ma.Count() + 1
+}
+
+// CalcSeries calculates ATR for every window of Count() candles of the
+// provided slice. True ranges are calculated once for the whole series.
+func (atr ATR) CalcSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !atr.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < atr.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	return CalcMASeries(atr.ma, trueRanges(cc))
+}
+
+// Stream creates new ATR stream that calculates ATR from the most recent
+// candles each time a new candle is added.
+func (atr ATR) Stream() (*Stream[Candle, decimal.Decimal], error) {
+	if !atr.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(atr.Count(), atr.Calc)
+}
+
+// trueRanges calculates true range of every candle, except the first
+// one, by using the closing price of the candle preceding it.
+func trueRanges(cc []Candle) []decimal.Decimal {
+	if len(cc) < 2 {
+		return nil
+	}
+
+	res := make([]decimal.Decimal, len(cc)-1)
+
+	for i := range res {
+		res[i] = cc[i+1].TrueRange(cc[i])
+	}
+
+	return res
+}
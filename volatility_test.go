@@ -0,0 +1,175 @@
+package tango
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewATR(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Type   MAType
+		Result ATR
+		Error  error
+	}{
+		"Invalid provided moving average type": {
+			Length: 3,
+			Error:  ErrInvalidMA,
+		},
+		"Invalid length": {
+			Type:  MATypeSmoothed,
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new ATR": {
+			Length: 3,
+			Type:   MATypeSmoothed,
+			Result: ATR{
+				valid: true,
+				ma: SMMA{
+					valid: true,
+					sma: SMA{
+						valid:  true,
+						length: 3,
+					},
+				},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewATR(c.Length, c.Type)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ATR_Calc(t *testing.T) {
+	cc := map[string]struct {
+		ATR    ATR
+		Data   []Candle
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ATR: ATR{
+				valid: true,
+				ma:    SMA{valid: true, length: 2},
+			},
+			Data:  candleTestData()[:2],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation with gaps": {
+			ATR: ATR{
+				valid: true,
+				ma:    SMA{valid: true, length: 2},
+			},
+			Data: []Candle{
+				{
+					High:  decimal.NewFromInt(12),
+					Low:   decimal.NewFromInt(9),
+					Close: decimal.NewFromInt(10),
+				},
+				{
+					High:  decimal.NewFromInt(15),
+					Low:   decimal.NewFromInt(13),
+					Close: decimal.NewFromInt(14),
+				},
+				{
+					High:  decimal.NewFromInt(13),
+					Low:   decimal.NewFromInt(8),
+					Close: decimal.NewFromInt(9),
+				},
+			},
+			Result: decimal.RequireFromString("5.5"),
+		},
+		"Successful calculation with Wilder smoothing": {
+			ATR: ATR{
+				valid: true,
+				ma: SMMA{
+					valid: true,
+					sma:   SMA{valid: true, length: 3},
+				},
+			},
+			Data:   candleTestData()[:6],
+			Result: decimal.RequireFromString("0.9340740740740741"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ATR.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.String())
+		})
+	}
+}
+
+func Test_ATR_Count(t *testing.T) {
+	assert.Equal(t, 6, ATR{
+		ma: SMMA{
+			sma: SMA{
+				length: 3,
+			},
+		},
+	}.Count())
+}
+
+func Test_ATR_Stream(t *testing.T) {
+	_, err := ATR{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	atr := ATR{valid: true, ma: SMMA{valid: true, sma: SMA{valid: true, length: 3}}}
+
+	s, err := atr.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, atr.Count(), atr.Calc, candleTestData())
+}
+
+func Test_ATR_CalcSeries(t *testing.T) {
+	_, err := ATR{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	atr := ATR{valid: true, ma: SMA{valid: true, length: 3}}
+
+	_, err = atr.CalcSeries(candleTestData()[:3])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := atr.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, atr.Count(), atr.Calc, candleTestData())
+
+	atr = ATR{valid: true, ma: SMMA{valid: true, sma: SMA{valid: true, length: 3}}}
+
+	res, err = atr.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, atr.Count(), atr.Calc, candleTestData())
+	assert.Equal(t, "0.9340740740740741", res[0].String())
+}
+
+func Test_trueRanges(t *testing.T) {
+	assert.Nil(t, trueRanges(candleTestData()[:1]))
+	assert.Equal(t, []decimal.Decimal{
+		decimal.RequireFromString("0.79"),
+		decimal.RequireFromString("1.14"),
+	}, trueRanges(candleTestData()[:3]))
+}
@@ -0,0 +1,656 @@
+package tango
+
+import "github.com/shopspring/decimal"
+
+// ADL holds all the necessary information needed to calculate
+// accumulation/distribution line.
+// The zero value is not usable.
+type ADL struct {
+	// valid specifies whether ADL paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewADL validates provided configuration options and
+// creates new ADL indicator.
+func NewADL(length int) (ADL, error) {
+	adl := ADL{
+		length: length,
+	}
+
+	if err := adl.validate(); err != nil {
+		return ADL{}, err
+	}
+
+	return adl, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (adl *ADL) validate() error {
+	if adl.length < 1 {
+		return ErrInvalidLength
+	}
+
+	adl.valid = true
+
+	return nil
+}
+
+// Calc calculates ADL from the provided candles slice. The result is only
+// the change of the line over the window, that is the sum of money flow
+// volumes of its candles, and not the line itself. CalcCumulativeSeries
+// should be used to get the running total of the line.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/a/accumulationdistribution.asp.
+// All credits are due to Marc Chaikin who developed ADL indicator.
+func (adl ADL) Calc(cc []Candle) (decimal.Decimal, error) {
+	if !adl.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != adl.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	var res decimal.Decimal
+
+	for i := range cc {
+		res = res.Add(moneyFlowVolume(cc[i]))
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for ADL
+// calculation.
+func (adl ADL) Count() int {
+	return adl.length
+}
+
+// CalcSeries calculates ADL for every window of Count() candles of the
+// provided slice. Just like Calc, every window starts at zero, so the
+// values are identical to Calc. Rolling sums are used, so the calculation
+// takes linear time.
+func (adl ADL) CalcSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !adl.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < adl.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	ff := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		ff[i] = moneyFlowVolume(cc[i])
+	}
+
+	return rollingSums(ff, adl.Count()), nil
+}
+
+// CalcCumulativeSeries calculates the running total of ADL for every
+// candle of the provided slice. The line starts at zero before the first
+// candle of the slice.
+func (adl ADL) CalcCumulativeSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !adl.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < 1 {
+		return nil, ErrInvalidDataSize
+	}
+
+	return accumulationDistribution(cc), nil
+}
+
+// Stream creates new ADL stream that calculates ADL from the most recent
+// candles each time a new candle is added.
+func (adl ADL) Stream() (*Stream[Candle, decimal.Decimal], error) {
+	if !adl.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(adl.Count(), adl.Calc)
+}
+
+// ChaikinOsc holds all the necessary information needed to calculate
+// Chaikin oscillator.
+// The zero value is not usable.
+type ChaikinOsc struct {
+	// valid specifies whether ChaikinOsc paremeters were validated.
+	valid bool
+
+	// fast specifies fast MA indicator configuration.
+	fast MA
+
+	// slow specifies slow MA indicator configuration.
+	slow MA
+}
+
+// NewChaikinOsc validates provided configuration options and creates new
+// ChaikinOsc indicator. The same moving average type is used for fast
+// and slow lines, the original indicator uses 3 and 10 period EMAs.
+func NewChaikinOsc(fast, slow int, mat MAType) (ChaikinOsc, error) {
+	fastMA, err := NewMA(mat, fast)
+	if err != nil {
+		return ChaikinOsc{}, err
+	}
+
+	slowMA, err := NewMA(mat, slow)
+	if err != nil {
+		return ChaikinOsc{}, err
+	}
+
+	co := ChaikinOsc{
+		fast: fastMA,
+		slow: slowMA,
+	}
+
+	if err := co.validate(); err != nil {
+		return ChaikinOsc{}, err
+	}
+
+	return co, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (co *ChaikinOsc) validate() error {
+	if co.fast.Count() >= co.slow.Count() {
+		return ErrInvalidLength
+	}
+
+	co.valid = true
+
+	return nil
+}
+
+// Calc calculates ChaikinOsc from the provided candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/c/chaikinoscillator.asp.
+// All credits are due to Marc Chaikin who developed Chaikin oscillator.
+func (co ChaikinOsc) Calc(cc []Candle) (decimal.Decimal, error) {
+	if !co.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != co.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	adl := accumulationDistribution(cc)
+
+	fast, err := co.fast.Calc(adl[len(adl)-co.fast.Count():])
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	slow, err := co.slow.Calc(adl)
+	if err != nil {
+		// unlikely to happen
+		return decimal.Zero, err
+	}
+
+	return fast.Sub(slow), nil
+}
+
+// Count determines the total amount of candles needed for ChaikinOsc
+// calculation.
+func (co ChaikinOsc) Count() int {
+	return co.slow.Count()
+}
+
+// CalcSeries calculates ChaikinOsc for every window of Count() candles
+// of the provided slice. The accumulation distribution line and both
+// moving averages are recalculated for every window, so the values are
+// identical to the ones Calc produces.
+func (co ChaikinOsc) CalcSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !co.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return calcWindows(co.Count(), cc, co.Calc)
+}
+
+// Stream creates new ChaikinOsc stream that calculates ChaikinOsc from
+// the most recent candles each time a new candle is added.
+func (co ChaikinOsc) Stream() (*Stream[Candle, decimal.Decimal], error) {
+	if !co.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(co.Count(), co.Calc)
+}
+
+// CMF holds all the necessary information needed to calculate Chaikin
+// money flow.
+// The zero value is not usable.
+type CMF struct {
+	// valid specifies whether CMF paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewCMF validates provided configuration options and
+// creates new CMF indicator.
+func NewCMF(length int) (CMF, error) {
+	cmf := CMF{
+		length: length,
+	}
+
+	if err := cmf.validate(); err != nil {
+		return CMF{}, err
+	}
+
+	return cmf, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (cmf *CMF) validate() error {
+	if cmf.length < 1 {
+		return ErrInvalidLength
+	}
+
+	cmf.valid = true
+
+	return nil
+}
+
+// Calc calculates CMF from the provided candles slice.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/ask/answers/071414/whats-difference-between-chaikin-money-flow-cmf-and-money-flow-index-mfi.asp.
+// All credits are due to Marc Chaikin who developed CMF indicator.
+func (cmf CMF) Calc(cc []Candle) (decimal.Decimal, error) {
+	if !cmf.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != cmf.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	var flow, volume decimal.Decimal
+
+	for i := range cc {
+		flow = flow.Add(moneyFlowVolume(cc[i]))
+		volume = volume.Add(cc[i].Volume)
+	}
+
+	return cmf.calc(flow, volume), nil
+}
+
+// calc calculates CMF from the sums of money flow volumes and volumes.
+func (cmf CMF) calc(flow, volume decimal.Decimal) decimal.Decimal {
+	if volume.IsZero() {
+		return decimal.Zero
+	}
+
+	return flow.Div(volume)
+}
+
+// Count determines the total amount of candles needed for CMF
+// calculation.
+func (cmf CMF) Count() int {
+	return cmf.length
+}
+
+// CalcSeries calculates CMF for every window of Count() candles of the
+// provided slice. Rolling sums are used, so the calculation takes linear
+// time.
+func (cmf CMF) CalcSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !cmf.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < cmf.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	ff := make([]decimal.Decimal, len(cc))
+	vv := make([]decimal.Decimal, len(cc))
+
+	for i := range cc {
+		ff[i] = moneyFlowVolume(cc[i])
+		vv[i] = cc[i].Volume
+	}
+
+	flows := rollingSums(ff, cmf.Count())
+	volumes := rollingSums(vv, cmf.Count())
+	res := make([]decimal.Decimal, len(flows))
+
+	for i := range res {
+		res[i] = cmf.calc(flows[i], volumes[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new CMF stream that calculates CMF from the most recent
+// candles each time a new candle is added.
+func (cmf CMF) Stream() (*Stream[Candle, decimal.Decimal], error) {
+	if !cmf.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(cmf.Count(), cmf.Calc)
+}
+
+// MFI holds all the necessary information needed to calculate money
+// flow index.
+// The zero value is not usable.
+type MFI struct {
+	// valid specifies whether MFI paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewMFI validates provided configuration options and
+// creates new MFI indicator.
+func NewMFI(length int) (MFI, error) {
+	mfi := MFI{
+		length: length,
+	}
+
+	if err := mfi.validate(); err != nil {
+		return MFI{}, err
+	}
+
+	return mfi, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (mfi *MFI) validate() error {
+	if mfi.length < 1 {
+		return ErrInvalidLength
+	}
+
+	mfi.valid = true
+
+	return nil
+}
+
+// Calc calculates MFI from the provided candles slice. The first candle
+// is used only for its typical price, which is needed to determine the
+// direction of the money flow of the second candle.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/m/mfi.asp.
+// All credits are due to Gene Quong and Avrum Soudack who developed
+// MFI indicator.
+func (mfi MFI) Calc(cc []Candle) (decimal.Decimal, error) {
+	if !mfi.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != mfi.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	var pos, neg decimal.Decimal
+
+	pp, nn := moneyFlows(cc)
+
+	for i := range pp {
+		pos = pos.Add(pp[i])
+		neg = neg.Add(nn[i])
+	}
+
+	return mfi.calc(pos, neg), nil
+}
+
+// calc calculates MFI from the sums of positive and negative money flows.
+// A window without any money flow produces the neutral value of 50.
+func (mfi MFI) calc(pos, neg decimal.Decimal) decimal.Decimal {
+	if pos.IsZero() && neg.IsZero() {
+		return decimal.NewFromInt(50)
+	}
+
+	if neg.IsZero() {
+		return _hundred
+	}
+
+	return pos.Mul(_hundred).Div(pos.Add(neg))
+}
+
+// Count determines the total amount of candles needed for MFI
+// calculation.
+func (mfi MFI) Count() int {
+	return mfi.length + 1
+}
+
+// CalcSeries calculates MFI for every window of Count() candles of the
+// provided slice. Rolling sums are used, so the calculation takes linear
+// time.
+func (mfi MFI) CalcSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !mfi.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < mfi.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	pp, nn := moneyFlows(cc)
+	pos := rollingSums(pp, mfi.length)
+	neg := rollingSums(nn, mfi.length)
+	res := make([]decimal.Decimal, len(pos))
+
+	for i := range res {
+		res[i] = mfi.calc(pos[i], neg[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new MFI stream that calculates MFI from the most recent
+// candles each time a new candle is added.
+func (mfi MFI) Stream() (*Stream[Candle, decimal.Decimal], error) {
+	if !mfi.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(mfi.Count(), mfi.Calc)
+}
+
+// OBV holds all the necessary information needed to calculate
+// on-balance volume.
+// The zero value is not usable.
+type OBV struct {
+	// valid specifies whether OBV paremeters were validated.
+	valid bool
+
+	// length specifies how many candles should be used
+	// during the calculations.
+	length int
+}
+
+// NewOBV validates provided configuration options and
+// creates new OBV indicator.
+func NewOBV(length int) (OBV, error) {
+	obv := OBV{
+		length: length,
+	}
+
+	if err := obv.validate(); err != nil {
+		return OBV{}, err
+	}
+
+	return obv, nil
+}
+
+// validate checks whether the indicator has valid configuration properties.
+func (obv *OBV) validate() error {
+	if obv.length < 1 {
+		return ErrInvalidLength
+	}
+
+	obv.valid = true
+
+	return nil
+}
+
+// Calc calculates OBV from the provided candles slice. The first candle
+// is used only for its closing price and the result is only the change of
+// OBV over the window, that is the sum of signed volumes of the other
+// candles, and not the line itself. CalcCumulativeSeries should be used to
+// get the running total of the line.
+// Calculation is based on formula provided by investopedia.
+// https://www.investopedia.com/terms/o/onbalancevolume.asp.
+// All credits are due to Joseph Granville who developed OBV indicator.
+func (obv OBV) Calc(cc []Candle) (decimal.Decimal, error) {
+	if !obv.valid {
+		return decimal.Zero, ErrInvalidIndicator
+	}
+
+	if len(cc) != obv.Count() {
+		return decimal.Zero, ErrInvalidDataSize
+	}
+
+	var res decimal.Decimal
+
+	for _, v := range signedVolumes(cc) {
+		res = res.Add(v)
+	}
+
+	return res, nil
+}
+
+// Count determines the total amount of candles needed for OBV
+// calculation.
+func (obv OBV) Count() int {
+	return obv.length + 1
+}
+
+// CalcSeries calculates OBV for every window of Count() candles of the
+// provided slice. Just like Calc, every window starts at zero, so the
+// values are identical to Calc. Rolling sums are used, so the calculation
+// takes linear time.
+func (obv OBV) CalcSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !obv.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < obv.Count() {
+		return nil, ErrInvalidDataSize
+	}
+
+	return rollingSums(signedVolumes(cc), obv.length), nil
+}
+
+// CalcCumulativeSeries calculates the running total of OBV for every
+// candle of the provided slice. The line starts at zero at the first
+// candle of the slice.
+func (obv OBV) CalcCumulativeSeries(cc []Candle) ([]decimal.Decimal, error) {
+	if !obv.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	if len(cc) < 1 {
+		return nil, ErrInvalidDataSize
+	}
+
+	vv := signedVolumes(cc)
+	res := make([]decimal.Decimal, len(cc))
+
+	for i := range vv {
+		res[i+1] = res[i].Add(vv[i])
+	}
+
+	return res, nil
+}
+
+// Stream creates new OBV stream that calculates OBV from the most recent
+// candles each time a new candle is added.
+func (obv OBV) Stream() (*Stream[Candle, decimal.Decimal], error) {
+	if !obv.valid {
+		return nil, ErrInvalidIndicator
+	}
+
+	return NewStream(obv.Count(), obv.Calc)
+}
+
+// moneyFlowVolume calculates money flow volume of the candle, which is
+// its volume weighted by the position of the close within the range.
+func moneyFlowVolume(c Candle) decimal.Decimal {
+	if c.Range().IsZero() {
+		return decimal.Zero
+	}
+
+	return c.Close.Sub(c.Low).Sub(c.High.Sub(c.Close)).
+		Div(c.Range()).Mul(c.Volume)
+}
+
+// accumulationDistribution calculates accumulation/distribution line
+// value of every candle, starting from zero before the first one.
+func accumulationDistribution(cc []Candle) []decimal.Decimal {
+	res := make([]decimal.Decimal, len(cc))
+
+	var curr decimal.Decimal
+
+	for i := range cc {
+		curr = curr.Add(moneyFlowVolume(cc[i]))
+		res[i] = curr
+	}
+
+	return res
+}
+
+// signedVolumes calculates the volume of every candle, except the first
+// one, signed by the direction of its closing price compared to the one
+// of the candle preceding it. Unchanged closing prices produce zero.
+func signedVolumes(cc []Candle) []decimal.Decimal {
+	if len(cc) < 2 {
+		return nil
+	}
+
+	res := make([]decimal.Decimal, len(cc)-1)
+
+	for i := range res {
+		switch cc[i+1].Close.Cmp(cc[i].Close) {
+		case 1:
+			res[i] = cc[i+1].Volume
+		case -1:
+			res[i] = cc[i+1].Volume.Neg()
+		}
+	}
+
+	return res
+}
+
+// moneyFlows calculates positive and negative money flows of every
+// candle, except the first one, by comparing its typical price with the
+// one of the candle preceding it.
+func moneyFlows(cc []Candle) (pos, neg []decimal.Decimal) {
+	if len(cc) < 2 {
+		return nil, nil
+	}
+
+	pos = make([]decimal.Decimal, len(cc)-1)
+	neg = make([]decimal.Decimal, len(cc)-1)
+
+	prev := cc[0].TypicalPrice()
+
+	for i := range pos {
+		curr := cc[i+1].TypicalPrice()
+
+		switch curr.Cmp(prev) {
+		case 1:
+			pos[i] = curr.Mul(cc[i+1].Volume)
+		case -1:
+			neg[i] = curr.Mul(cc[i+1].Volume)
+		}
+
+		prev = curr
+	}
+
+	return pos, neg
+}
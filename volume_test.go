@@ -0,0 +1,718 @@
+package tango
+
+import (
+	"testing"
+
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+)
+
+func Test_NewADL(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result ADL
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new ADL": {
+			Length: 5,
+			Result: ADL{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewADL(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ADL_Calc(t *testing.T) {
+	cc := map[string]struct {
+		ADL    ADL
+		Data   []Candle
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ADL:   ADL{valid: true, length: 5},
+			Data:  candleTestData()[:4],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ADL:    ADL{valid: true, length: 5},
+			Data:   candleTestData()[:5],
+			Result: decimal.RequireFromString("823.55880654"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ADL.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ADL_Count(t *testing.T) {
+	assert.Equal(t, 5, ADL{length: 5}.Count())
+}
+
+func Test_ADL_Stream(t *testing.T) {
+	_, err := ADL{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	adl := ADL{valid: true, length: 5}
+
+	s, err := adl.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, adl.Count(), adl.Calc, candleTestData())
+}
+
+func Test_ADL_CalcSeries(t *testing.T) {
+	_, err := ADL{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	adl := ADL{valid: true, length: 5}
+
+	_, err = adl.CalcSeries(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := adl.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, adl.Count(), adl.Calc, candleTestData())
+}
+
+func Test_ADL_CalcCumulativeSeries(t *testing.T) {
+	_, err := ADL{}.CalcCumulativeSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	adl := ADL{valid: true, length: 5}
+
+	_, err = adl.CalcCumulativeSeries(nil)
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	cc := candleTestData()
+
+	res, err := adl.CalcCumulativeSeries(cc)
+	assert.NoError(t, err)
+
+	if !assert.Len(t, res, len(cc)) {
+		return
+	}
+
+	for i := range res {
+		exp, err := ADL{valid: true, length: i + 1}.Calc(cc[:i+1])
+		assert.NoError(t, err)
+		assert.Equal(t, exp.String(), res[i].String())
+	}
+
+	for i := adl.Count(); i < len(res); i++ {
+		exp, err := adl.Calc(cc[i-adl.Count()+1 : i+1])
+		assert.NoError(t, err)
+		assert.Equal(t, exp.String(), res[i].Sub(res[i-adl.Count()]).String())
+	}
+}
+
+func Test_NewChaikinOsc(t *testing.T) {
+	cc := map[string]struct {
+		Fast   int
+		Slow   int
+		Type   MAType
+		Result ChaikinOsc
+		Error  error
+	}{
+		"Invalid fast length": {
+			Slow:  5,
+			Type:  MATypeSimple,
+			Error: ErrInvalidLength,
+		},
+		"Invalid slow length": {
+			Fast:  3,
+			Type:  MATypeSimple,
+			Error: ErrInvalidLength,
+		},
+		"Invalid provided moving average type": {
+			Fast:  3,
+			Slow:  5,
+			Error: ErrInvalidMA,
+		},
+		"Validate returns an error": {
+			Fast:  5,
+			Slow:  3,
+			Type:  MATypeSimple,
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new ChaikinOsc": {
+			Fast: 3,
+			Slow: 5,
+			Type: MATypeSimple,
+			Result: ChaikinOsc{
+				valid: true,
+				fast:  SMA{valid: true, length: 3},
+				slow:  SMA{valid: true, length: 5},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewChaikinOsc(c.Fast, c.Slow, c.Type)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_ChaikinOsc_validate(t *testing.T) {
+	cc := map[string]struct {
+		ChaikinOsc ChaikinOsc
+		Error      error
+	}{
+		"Fast length equal to slow length": {
+			ChaikinOsc: ChaikinOsc{
+				fast: SMA{valid: true, length: 3},
+				slow: SMA{valid: true, length: 3},
+			},
+			Error: ErrInvalidLength,
+		},
+		"Successful validation": {
+			ChaikinOsc: ChaikinOsc{
+				fast: SMA{valid: true, length: 2},
+				slow: SMA{valid: true, length: 5},
+			},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			err := c.ChaikinOsc.validate()
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Error == nil, c.ChaikinOsc.valid)
+		})
+	}
+}
+
+func Test_ChaikinOsc_Calc(t *testing.T) {
+	co := ChaikinOsc{
+		valid: true,
+		fast:  SMA{valid: true, length: 2},
+		slow:  SMA{valid: true, length: 5},
+	}
+
+	cc := map[string]struct {
+		ChaikinOsc ChaikinOsc
+		Data       []Candle
+		Result     decimal.Decimal
+		Error      error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			ChaikinOsc: co,
+			Data:       candleTestData()[:4],
+			Error:      ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			ChaikinOsc: co,
+			Data:       candleTestData()[:5],
+			Result:     decimal.RequireFromString("309.36881618"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.ChaikinOsc.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(8).String())
+		})
+	}
+}
+
+func Test_ChaikinOsc_Count(t *testing.T) {
+	assert.Equal(t, 5, ChaikinOsc{
+		fast: SMA{length: 2},
+		slow: SMA{length: 5},
+	}.Count())
+}
+
+func Test_ChaikinOsc_Stream(t *testing.T) {
+	_, err := ChaikinOsc{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	co := ChaikinOsc{
+		valid: true,
+		fast:  EMA{valid: true, sma: SMA{valid: true, length: 2}},
+		slow:  EMA{valid: true, sma: SMA{valid: true, length: 4}},
+	}
+
+	s, err := co.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, co.Count(), co.Calc, candleTestData())
+}
+
+func Test_ChaikinOsc_CalcSeries(t *testing.T) {
+	_, err := ChaikinOsc{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	co := ChaikinOsc{
+		valid: true,
+		fast:  SMA{valid: true, length: 2},
+		slow:  SMA{valid: true, length: 5},
+	}
+
+	_, err = co.CalcSeries(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := co.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, co.Count(), co.Calc, candleTestData())
+
+	co.fast = EMA{valid: true, sma: SMA{valid: true, length: 2}}
+	co.slow = EMA{valid: true, sma: SMA{valid: true, length: 3}}
+
+	res, err = co.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, co.Count(), co.Calc, candleTestData())
+}
+
+func Test_NewCMF(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result CMF
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new CMF": {
+			Length: 5,
+			Result: CMF{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewCMF(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_CMF_Calc(t *testing.T) {
+	cc := map[string]struct {
+		CMF    CMF
+		Data   []Candle
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			CMF:   CMF{valid: true, length: 5},
+			Data:  candleTestData()[:4],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation without volume": {
+			CMF: CMF{valid: true, length: 1},
+			Data: []Candle{
+				{High: decimal.NewFromInt(3), Low: decimal.NewFromInt(1), Close: decimal.NewFromInt(2)},
+			},
+		},
+		"Successful calculation": {
+			CMF:    CMF{valid: true, length: 5},
+			Data:   candleTestData()[:5],
+			Result: decimal.RequireFromString("0.1372598"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.CMF.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(7).String())
+		})
+	}
+}
+
+func Test_CMF_Count(t *testing.T) {
+	assert.Equal(t, 5, CMF{length: 5}.Count())
+}
+
+func Test_CMF_Stream(t *testing.T) {
+	_, err := CMF{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	cmf := CMF{valid: true, length: 5}
+
+	s, err := cmf.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, cmf.Count(), cmf.Calc, candleTestData())
+}
+
+func Test_CMF_CalcSeries(t *testing.T) {
+	_, err := CMF{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	cmf := CMF{valid: true, length: 5}
+
+	_, err = cmf.CalcSeries(candleTestData()[:4])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := cmf.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, cmf.Count(), cmf.Calc, candleTestData())
+}
+
+func Test_NewMFI(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result MFI
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new MFI": {
+			Length: 5,
+			Result: MFI{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewMFI(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_MFI_Calc(t *testing.T) {
+	cc := map[string]struct {
+		MFI    MFI
+		Data   []Candle
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			MFI:   MFI{valid: true, length: 5},
+			Data:  candleTestData()[:5],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation without negative money flow": {
+			MFI: MFI{valid: true, length: 2},
+			Data: []Candle{
+				{High: decimal.NewFromInt(3), Low: decimal.NewFromInt(1), Close: decimal.NewFromInt(2), Volume: decimal.NewFromInt(10)},
+				{High: decimal.NewFromInt(4), Low: decimal.NewFromInt(2), Close: decimal.NewFromInt(3), Volume: decimal.NewFromInt(10)},
+				{High: decimal.NewFromInt(4), Low: decimal.NewFromInt(2), Close: decimal.NewFromInt(3), Volume: decimal.NewFromInt(10)},
+			},
+			Result: decimal.NewFromInt(100),
+		},
+		"Successful calculation without any money flow": {
+			MFI: MFI{valid: true, length: 2},
+			Data: []Candle{
+				{High: decimal.NewFromInt(3), Low: decimal.NewFromInt(1), Close: decimal.NewFromInt(2), Volume: decimal.NewFromInt(10)},
+				{High: decimal.NewFromInt(3), Low: decimal.NewFromInt(1), Close: decimal.NewFromInt(2), Volume: decimal.NewFromInt(10)},
+				{High: decimal.NewFromInt(3), Low: decimal.NewFromInt(1), Close: decimal.NewFromInt(2), Volume: decimal.NewFromInt(10)},
+			},
+			Result: decimal.NewFromInt(50),
+		},
+		"Successful calculation": {
+			MFI:    MFI{valid: true, length: 5},
+			Data:   candleTestData()[:6],
+			Result: decimal.RequireFromString("60.1535011"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.MFI.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(7).String())
+		})
+	}
+}
+
+func Test_MFI_Count(t *testing.T) {
+	assert.Equal(t, 6, MFI{length: 5}.Count())
+}
+
+func Test_MFI_Stream(t *testing.T) {
+	_, err := MFI{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	mfi := MFI{valid: true, length: 5}
+
+	s, err := mfi.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, mfi.Count(), mfi.Calc, candleTestData())
+}
+
+func Test_MFI_CalcSeries(t *testing.T) {
+	_, err := MFI{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	mfi := MFI{valid: true, length: 5}
+
+	_, err = mfi.CalcSeries(candleTestData()[:5])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := mfi.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, mfi.Count(), mfi.Calc, candleTestData())
+}
+
+func Test_NewOBV(t *testing.T) {
+	cc := map[string]struct {
+		Length int
+		Result OBV
+		Error  error
+	}{
+		"Invalid length": {
+			Error: ErrInvalidLength,
+		},
+		"Successfully created new OBV": {
+			Length: 5,
+			Result: OBV{valid: true, length: 5},
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := NewOBV(c.Length)
+			assertEqualError(t, c.Error, err)
+			assert.Equal(t, c.Result, res)
+		})
+	}
+}
+
+func Test_OBV_Calc(t *testing.T) {
+	cc := map[string]struct {
+		OBV    OBV
+		Data   []Candle
+		Result decimal.Decimal
+		Error  error
+	}{
+		"Invalid indicator": {
+			Error: ErrInvalidIndicator,
+		},
+		"Invalid data size": {
+			OBV:   OBV{valid: true, length: 5},
+			Data:  candleTestData()[:5],
+			Error: ErrInvalidDataSize,
+		},
+		"Successful calculation": {
+			OBV:    OBV{valid: true, length: 5},
+			Data:   candleTestData()[:6],
+			Result: decimal.RequireFromString("1200"),
+		},
+	}
+
+	for cn, c := range cc {
+		c := c
+
+		t.Run(cn, func(t *testing.T) {
+			t.Parallel()
+
+			res, err := c.OBV.Calc(c.Data)
+			assertEqualError(t, c.Error, err)
+
+			if err != nil {
+				return
+			}
+
+			assert.Equal(t, c.Result.String(), res.Round(0).String())
+		})
+	}
+}
+
+func Test_OBV_Count(t *testing.T) {
+	assert.Equal(t, 6, OBV{length: 5}.Count())
+}
+
+func Test_OBV_Stream(t *testing.T) {
+	_, err := OBV{}.Stream()
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	obv := OBV{valid: true, length: 5}
+
+	s, err := obv.Stream()
+	assert.NoError(t, err)
+	assertStreamMatchesCalc(t, s, obv.Count(), obv.Calc, candleTestData())
+}
+
+func Test_OBV_CalcSeries(t *testing.T) {
+	_, err := OBV{}.CalcSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	obv := OBV{valid: true, length: 5}
+
+	_, err = obv.CalcSeries(candleTestData()[:5])
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	res, err := obv.CalcSeries(candleTestData())
+	assert.NoError(t, err)
+	assertSeriesMatchesCalc(t, res, obv.Count(), obv.Calc, candleTestData())
+}
+
+func Test_OBV_CalcCumulativeSeries(t *testing.T) {
+	_, err := OBV{}.CalcCumulativeSeries(candleTestData())
+	assertEqualError(t, ErrInvalidIndicator, err)
+
+	obv := OBV{valid: true, length: 5}
+
+	_, err = obv.CalcCumulativeSeries(nil)
+	assertEqualError(t, ErrInvalidDataSize, err)
+
+	cc := candleTestData()
+
+	res, err := obv.CalcCumulativeSeries(cc)
+	assert.NoError(t, err)
+
+	if !assert.Len(t, res, len(cc)) {
+		return
+	}
+
+	assert.Equal(t, "0", res[0].String())
+
+	for i := 1; i < len(res); i++ {
+		exp, err := OBV{valid: true, length: i}.Calc(cc[:i+1])
+		assert.NoError(t, err)
+		assert.Equal(t, exp.String(), res[i].String())
+	}
+
+	for i := obv.length; i < len(res); i++ {
+		exp, err := obv.Calc(cc[i-obv.length : i+1])
+		assert.NoError(t, err)
+		assert.Equal(t, exp.String(), res[i].Sub(res[i-obv.length]).String())
+	}
+}
+
+func Test_moneyFlowVolume(t *testing.T) {
+	assert.Equal(t, "0", moneyFlowVolume(Candle{
+		High:   decimal.NewFromInt(2),
+		Low:    decimal.NewFromInt(2),
+		Close:  decimal.NewFromInt(2),
+		Volume: decimal.NewFromInt(10),
+	}).String())
+
+	assert.Equal(t, "5", moneyFlowVolume(Candle{
+		High:   decimal.NewFromInt(4),
+		Low:    decimal.NewFromInt(0),
+		Close:  decimal.NewFromInt(3),
+		Volume: decimal.NewFromInt(10),
+	}).String())
+}
+
+func Test_signedVolumes(t *testing.T) {
+	assert.Nil(t, signedVolumes(candleTestData()[:1]))
+
+	res := signedVolumes([]Candle{
+		{Close: decimal.NewFromInt(2), Volume: decimal.NewFromInt(10)},
+		{Close: decimal.NewFromInt(3), Volume: decimal.NewFromInt(20)},
+		{Close: decimal.NewFromInt(3), Volume: decimal.NewFromInt(30)},
+		{Close: decimal.NewFromInt(1), Volume: decimal.NewFromInt(5)},
+	})
+
+	assert.Len(t, res, 3)
+	assert.Equal(t, "20", res[0].String())
+	assert.Equal(t, "0", res[1].String())
+	assert.Equal(t, "-5", res[2].String())
+}
+
+func Test_moneyFlows(t *testing.T) {
+	pos, neg := moneyFlows(candleTestData()[:1])
+	assert.Nil(t, pos)
+	assert.Nil(t, neg)
+
+	pos, neg = moneyFlows([]Candle{
+		{High: decimal.NewFromInt(2), Low: decimal.NewFromInt(2), Close: decimal.NewFromInt(2), Volume: decimal.NewFromInt(10)},
+		{High: decimal.NewFromInt(3), Low: decimal.NewFromInt(3), Close: decimal.NewFromInt(3), Volume: decimal.NewFromInt(20)},
+		{High: decimal.NewFromInt(3), Low: decimal.NewFromInt(3), Close: decimal.NewFromInt(3), Volume: decimal.NewFromInt(30)},
+		{High: decimal.NewFromInt(1), Low: decimal.NewFromInt(1), Close: decimal.NewFromInt(1), Volume: decimal.NewFromInt(5)},
+	})
+
+	assert.Len(t, pos, 3)
+	assert.Len(t, neg, 3)
+	assert.Equal(t, "60", pos[0].String())
+	assert.Equal(t, "0", neg[0].String())
+	assert.Equal(t, "0", pos[1].String())
+	assert.Equal(t, "0", neg[1].String())
+	assert.Equal(t, "0", pos[2].String())
+	assert.Equal(t, "5", neg[2].String())
+}